@@ -0,0 +1,119 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// Upgrade identifies a consensus upgrade activated at a fixed height.
+type Upgrade string
+
+// These are the upgrades with activation heights in Params.
+const (
+	UpgradeBIP34     Upgrade = "bip34"
+	UpgradeBIP65     Upgrade = "bip65"
+	UpgradeBIP66     Upgrade = "bip66"
+	UpgradeCSV       Upgrade = "csv"
+	UpgradeUAHF      Upgrade = "uahf"
+	UpgradeDAA       Upgrade = "daa"
+	UpgradeGenesis   Upgrade = "genesis"
+	UpgradeChronicle Upgrade = "chronicle"
+)
+
+// Upgrades lists every upgrade in activation order on the main network.
+var Upgrades = []Upgrade{
+	UpgradeBIP34,
+	UpgradeBIP66,
+	UpgradeBIP65,
+	UpgradeCSV,
+	UpgradeUAHF,
+	UpgradeDAA,
+	UpgradeGenesis,
+	UpgradeChronicle,
+}
+
+// ErrActivationBlockMismatch describes an error where a block at an
+// upgrade's activation height does not match the network's ActivationBlocks
+// entry.
+var ErrActivationBlockMismatch = errors.New("block does not match the recorded activation block")
+
+// ActivationHeight returns the height at which the upgrade activates on the
+// network, as set by the corresponding Params field, and false for an
+// unknown upgrade.
+func (p *Params) ActivationHeight(u Upgrade) (int32, bool) {
+	switch u {
+	case UpgradeBIP34:
+		return p.BIP0034Height, true
+	case UpgradeBIP65:
+		return p.BIP0065Height, true
+	case UpgradeBIP66:
+		return p.BIP0066Height, true
+	case UpgradeCSV:
+		return uint32Height(p.CSVHeight), true
+	case UpgradeUAHF:
+		return uint32Height(p.UahfForkHeight), true
+	case UpgradeDAA:
+		return uint32Height(p.DaaForkHeight), true
+	case UpgradeGenesis:
+		return uint32Height(p.GenesisActivationHeight), true
+	case UpgradeChronicle:
+		return uint32Height(p.ChronicleActivationHeight), true
+	}
+
+	return 0, false
+}
+
+// CheckActivationBlock checks a block against the network's ActivationBlocks.
+//
+// Header sync calls it for every connected header; it only rejects a block
+// whose height is an activation height with a recorded hash and whose hash
+// differs from that record.  Like a checkpoint, it confirms the chain passed
+// through the recorded blocks; it does not tell the sides of a chain split
+// apart, since the recorded UAHF block is the last one shared with BTC.  Use
+// CheckChainBlock for that.
+//
+// Parameters:
+//
+//	height - the block height.
+//	hash - the block hash.
+//
+// Returns:
+//
+//	error - nil if the block matches or no record applies, otherwise ErrActivationBlockMismatch.
+func (p *Params) CheckActivationBlock(height int32, hash *chainhash.Hash) error {
+	for _, u := range p.activationUpgrades() {
+		block := p.ActivationBlocks[u]
+		if block.Height != height || block.Hash == nil {
+			continue
+		}
+
+		if hash == nil || !block.Hash.IsEqual(hash) {
+			return fmt.Errorf("%w: %s activation block at height %d is %s, got %v",
+				ErrActivationBlockMismatch, u, height, block.Hash, hash)
+		}
+	}
+
+	return nil
+}
+
+// uint32Height converts a uint32 height field to int32, saturating at
+// math.MaxInt32.
+func uint32Height(h uint32) int32 {
+	return int32(min(h, math.MaxInt32)) //nolint:gosec // bounded above
+}
+
+// activationUpgrades returns the keys of ActivationBlocks in a stable order.
+func (p *Params) activationUpgrades() []Upgrade {
+	upgrades := make([]Upgrade, 0, len(p.ActivationBlocks))
+	for u := range p.ActivationBlocks {
+		upgrades = append(upgrades, u)
+	}
+
+	slices.Sort(upgrades)
+
+	return upgrades
+}
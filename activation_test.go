@@ -0,0 +1,96 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestActivationBlocksConsistent ensures every recorded activation block sits
+// at the height set by the matching activation field and that the hashes are
+// distinct from each other.
+func TestActivationBlocksConsistent(t *testing.T) {
+	for _, p := range builtinNets {
+		seen := make(map[chainhash.Hash]Upgrade)
+
+		for u, block := range p.ActivationBlocks {
+			height, ok := p.ActivationHeight(u)
+			require.True(t, ok, "%s: unknown upgrade %q", p.Name, u)
+			assert.Equal(t, height, block.Height, "%s: %s", p.Name, u)
+
+			require.NotNil(t, block.Hash, "%s: %s", p.Name, u)
+
+			if other, dup := seen[*block.Hash]; dup {
+				t.Errorf("%s: %s and %s share hash %s", p.Name, u, other, block.Hash)
+			}
+
+			seen[*block.Hash] = u
+
+			// Checkpoints at the same height must agree.
+			for _, cp := range p.Checkpoints {
+				if cp.Height == block.Height {
+					assert.Equal(t, cp.Hash, block.Hash, "%s: %s", p.Name, u)
+				}
+			}
+		}
+	}
+
+	assert.Len(t, MainNetParams.ActivationBlocks, 6)
+	assert.Len(t, TestNetParams.ActivationBlocks, 6)
+}
+
+// TestActivationHeight checks the upgrade to field mapping.
+func TestActivationHeight(t *testing.T) {
+	for _, u := range Upgrades {
+		_, ok := MainNetParams.ActivationHeight(u)
+		assert.True(t, ok, u)
+	}
+
+	h, _ := MainNetParams.ActivationHeight(UpgradeChronicle)
+	assert.Equal(t, int32(943816), h)
+
+	_, ok := MainNetParams.ActivationHeight("segwit")
+	assert.False(t, ok)
+}
+
+// TestCheckActivationBlock checks activation block verification.
+func TestCheckActivationBlock(t *testing.T) {
+	uahf := MainNetParams.ActivationBlocks[UpgradeUAHF]
+
+	require.NoError(t, MainNetParams.CheckActivationBlock(uahf.Height, uahf.Hash))
+	require.NoError(t, MainNetParams.CheckActivationBlock(uahf.Height+1, &chainhash.Hash{1}))
+	require.ErrorIs(t, MainNetParams.CheckActivationBlock(uahf.Height, &chainhash.Hash{1}), ErrActivationBlockMismatch)
+	require.ErrorIs(t, MainNetParams.CheckActivationBlock(uahf.Height, nil), ErrActivationBlockMismatch)
+	require.NoError(t, RegressionNetParams.CheckActivationBlock(uahf.Height, &chainhash.Hash{1}))
+
+	// The UAHF activation block is the last block before the split, so the
+	// chains are told apart by the block after it.
+	assert.False(t, MainNetParams.IsForkIDEnabled(uahf.Height))
+	assert.True(t, MainNetParams.IsForkIDEnabled(uahf.Height+1))
+	btc := MainNetParams.InvalidBlocks[0]
+	assert.Equal(t, uahf.Height+1, btc.Height)
+	require.NoError(t, MainNetParams.CheckActivationBlock(btc.Height, btc.Hash))
+	require.ErrorIs(t, MainNetParams.CheckChainBlock(btc.Height, btc.Hash), ErrForbiddenChain)
+}
+
+// TestActivationBlocksCloneAndDiff ensures the map is deep copied and
+// compared by value.
+func TestActivationBlocksCloneAndDiff(t *testing.T) {
+	c := MainNetParams.Clone()
+	assert.Empty(t, Diff(&MainNetParams, c))
+
+	c.ActivationBlocks[UpgradeGenesis] = Checkpoint{620538, &chainhash.Hash{}}
+	c.ActivationBlocks[UpgradeCSV].Hash[0] ^= 1
+
+	changes := Diff(&MainNetParams, c)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "ActivationBlocks[csv]", changes[0].Field)
+	assert.Equal(t, FieldChange{
+		Field: "ActivationBlocks[genesis]", Kind: ChangeAdded,
+		New: "620538:0000000000000000000000000000000000000000000000000000000000000000",
+	}, changes[1])
+
+	require.NoError(t, VerifyBuiltinsUnmodified())
+}
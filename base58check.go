@@ -0,0 +1,49 @@
+package chaincfg
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	base58 "github.com/bsv-blockchain/go-sdk/compat/base58"
+)
+
+// base58CheckSumLen is the length of the double-SHA256 checksum suffix used by
+// base58check encodings such as WIF keys and BIP32 extended keys.
+const base58CheckSumLen = 4
+
+var (
+	// errBase58CheckFormat is returned by base58CheckDecode when the input is
+	// not valid base58 or is too short to carry a checksum.
+	errBase58CheckFormat = errors.New("malformed base58check string")
+
+	// errBase58CheckSum is returned by base58CheckDecode when the checksum
+	// does not match the payload.
+	errBase58CheckSum = errors.New("bad base58check checksum")
+)
+
+// base58CheckEncode appends the double-SHA256 checksum to payload and returns
+// the base58 encoding of the result.
+func base58CheckEncode(payload []byte) string {
+	buf := make([]byte, 0, len(payload)+base58CheckSumLen)
+	buf = append(buf, payload...)
+	buf = append(buf, chainhash.DoubleHashB(payload)[:base58CheckSumLen]...)
+
+	return base58.Encode(buf)
+}
+
+// base58CheckDecode decodes s and verifies its checksum, returning the payload
+// without the checksum.
+func base58CheckDecode(s string) ([]byte, error) {
+	decoded, err := base58.Decode(s)
+	if err != nil || len(decoded) < base58CheckSumLen {
+		return nil, errBase58CheckFormat
+	}
+
+	payload, checksum := decoded[:len(decoded)-base58CheckSumLen], decoded[len(decoded)-base58CheckSumLen:]
+	if !bytes.Equal(chainhash.DoubleHashB(payload)[:base58CheckSumLen], checksum) {
+		return nil, errBase58CheckSum
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,87 @@
+package chaincfg
+
+// These constants describe the BIP0009 version bits block version format.
+const (
+	// VersionBitsTopMask masks the top three bits of a block version.
+	VersionBitsTopMask uint32 = 0xe0000000
+
+	// VersionBitsTopBits is the value of the top three bits of a block
+	// version using BIP0009 version bits.
+	VersionBitsTopBits uint32 = 0x20000000
+
+	// VersionBitsNumBits is the number of bits available for signaling
+	// deployments in a version bits block version.
+	VersionBitsNumBits = 29
+)
+
+// MinBlockVersion returns the lowest block header version accepted at the
+// given height.
+//
+// BIP0034, BIP0066 and BIP0065 raised the minimum to 2, 3 and 4 at
+// BIP0034Height, BIP0066Height and BIP0065Height.  Each rule applies on its
+// own, so a network that disables one of them, such as stn with BIP0034 at
+// 100000000, still enforces the others from their heights.
+func (p *Params) MinBlockVersion(height int32) int32 {
+	minVersion := int32(1)
+
+	if height >= p.BIP0034Height {
+		minVersion = max(minVersion, 2)
+	}
+
+	if height >= p.BIP0066Height {
+		minVersion = max(minVersion, 3)
+	}
+
+	if height >= p.BIP0065Height {
+		minVersion = max(minVersion, 4)
+	}
+
+	return minVersion
+}
+
+// IsValidBlockVersion reports whether a block header version is allowed at
+// the given height.
+//
+// Versions are compared as signed integers, as in the reference client, so
+// BIP0009 version bits versions (top bits 001) always satisfy the minimum
+// while versions with the top bit set are below it.
+func (p *Params) IsValidBlockVersion(version, height int32) bool {
+	return version >= p.MinBlockVersion(height)
+}
+
+// IsVersionBits reports whether a block version uses the BIP0009 version
+// bits format, with 001 in its top three bits.
+func IsVersionBits(version int32) bool {
+	return uint32(version)&VersionBitsTopMask == VersionBitsTopBits //nolint:gosec // bit pattern reinterpretation
+}
+
+// SignaledBits returns the BIP0009 deployment bits set in a version bits
+// block version, in ascending order, or nil if the version does not use the
+// version bits format.
+func SignaledBits(version int32) []uint8 {
+	if !IsVersionBits(version) {
+		return nil
+	}
+
+	var bits []uint8
+
+	for bit := range uint8(VersionBitsNumBits) {
+		if uint32(version)&(1<<bit) != 0 { //nolint:gosec // bit pattern reinterpretation
+			bits = append(bits, bit)
+		}
+	}
+
+	return bits
+}
+
+// SignalsDeployment reports whether a block version signals readiness for
+// the given deployment, one of DeploymentTestDummy or DeploymentCSV.
+func (p *Params) SignalsDeployment(version int32, deployment int) bool {
+	if deployment < 0 || deployment >= len(p.Deployments) || !IsVersionBits(version) {
+		return false
+	}
+
+	bit := p.Deployments[deployment].BitNumber
+
+	return bit < VersionBitsNumBits && uint32(version)&(1<<bit) != 0 //nolint:gosec // bit pattern reinterpretation
+}
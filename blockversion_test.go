@@ -0,0 +1,74 @@
+package chaincfg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMinBlockVersion checks the minimum version around each activation
+// height, including stn's disabled BIP0034.
+func TestMinBlockVersion(t *testing.T) {
+	tests := []struct {
+		params  *Params
+		height  int32
+		version int32
+	}{
+		{&MainNetParams, 0, 1},
+		{&MainNetParams, 227930, 1},
+		{&MainNetParams, 227931, 2},
+		{&MainNetParams, 363724, 2},
+		{&MainNetParams, 363725, 3},
+		{&MainNetParams, 388380, 3},
+		{&MainNetParams, 388381, 4},
+		{&MainNetParams, math.MaxInt32, 4},
+		{&TestNetParams, 21111, 2},
+		{&TestNetParams, 330776, 3},
+		{&TestNetParams, 581885, 4},
+		{&StnParams, 1250, 1},
+		{&StnParams, 1251, 3},
+		{&StnParams, 1351, 4},
+		{&RegressionNetParams, 1251, 3},
+		{&TeraTestNetParams, 1, 4},
+		{&TeraScalingTestNetParams, 1, 4},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.version, test.params.MinBlockVersion(test.height), "%s at %d", test.params.Name, test.height)
+		assert.True(t, test.params.IsValidBlockVersion(test.version, test.height))
+		assert.False(t, test.params.IsValidBlockVersion(test.version-1, test.height))
+	}
+}
+
+// TestIsValidBlockVersionBits checks version bits and negative versions.
+func TestIsValidBlockVersionBits(t *testing.T) {
+	height := int32(700000)
+
+	assert.True(t, MainNetParams.IsValidBlockVersion(0x20000000, height))
+	assert.True(t, MainNetParams.IsValidBlockVersion(0x3fffe000, height), "BIP320 version rolling")
+	assert.True(t, MainNetParams.IsValidBlockVersion(0x40000000, height))
+	assert.False(t, MainNetParams.IsValidBlockVersion(-0x20000000, height), "top bit set")
+	assert.True(t, StnParams.IsValidBlockVersion(1, 1250))
+}
+
+// TestSignaledBits checks decoding of BIP0009 version bits.
+func TestSignaledBits(t *testing.T) {
+	assert.True(t, IsVersionBits(0x20000000))
+	assert.True(t, IsVersionBits(0x3fffffff))
+	assert.False(t, IsVersionBits(4))
+	assert.False(t, IsVersionBits(0x40000000))
+	assert.False(t, IsVersionBits(-0x60000000))
+
+	assert.Empty(t, SignaledBits(0x20000000))
+	assert.Equal(t, []uint8{0}, SignaledBits(0x20000001))
+	assert.Equal(t, []uint8{0, 1, 28}, SignaledBits(0x30000003))
+	assert.Nil(t, SignaledBits(4))
+
+	assert.True(t, MainNetParams.SignalsDeployment(0x20000001, DeploymentCSV))
+	assert.False(t, MainNetParams.SignalsDeployment(0x20000001, DeploymentTestDummy))
+	assert.True(t, MainNetParams.SignalsDeployment(0x30000000, DeploymentTestDummy))
+	assert.False(t, MainNetParams.SignalsDeployment(0x00000001, DeploymentCSV), "not version bits")
+	assert.False(t, MainNetParams.SignalsDeployment(0x20000001, DefinedDeployments))
+	assert.False(t, MainNetParams.SignalsDeployment(0x20000001, -1))
+}
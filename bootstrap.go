@@ -0,0 +1,53 @@
+package chaincfg
+
+//go:generate go run ./cmd/seedgen -dir cmd/seedgen/nodes -out fixedseeds.go
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// ErrNoBootstrapAddrs describes an error where neither the DNS seeds nor the
+// fixed seeds of a network produced a peer address.
+var ErrNoBootstrapAddrs = errors.New("no bootstrap addresses")
+
+// BootstrapAddrs returns the initial peer addresses for the network: the
+// given DNS seed results followed by its fixed seeds, with duplicates
+// removed.
+//
+// The DNS seeds are resolved by the caller, typically through the seeds
+// package, so this package performs no network I/O.  Networks whose DNS
+// seeds failed or are absent still bootstrap from FixedSeeds.
+//
+// Parameters:
+//
+//	dnsAddrs - the peer addresses resolved from the network's DNS seeds; may be empty.
+//
+// Returns:
+//
+//	[]netip.AddrPort - the merged peer addresses.
+//	error - ErrNoBootstrapAddrs when no address was found.
+func (p *Params) BootstrapAddrs(dnsAddrs []netip.AddrPort) ([]netip.AddrPort, error) {
+	seen := make(map[netip.AddrPort]struct{}, len(dnsAddrs)+len(p.FixedSeeds))
+	merged := make([]netip.AddrPort, 0, len(dnsAddrs)+len(p.FixedSeeds))
+
+	for _, addrs := range [][]netip.AddrPort{dnsAddrs, p.FixedSeeds} {
+		for _, a := range addrs {
+			a = netip.AddrPortFrom(a.Addr().Unmap(), a.Port())
+			if _, ok := seen[a]; ok {
+				continue
+			}
+
+			seen[a] = struct{}{}
+
+			merged = append(merged, a)
+		}
+	}
+
+	if len(merged) == 0 {
+		return nil, fmt.Errorf("%w for %s", ErrNoBootstrapAddrs, p.Name)
+	}
+
+	return merged, nil
+}
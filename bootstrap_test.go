@@ -0,0 +1,59 @@
+package chaincfg
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBootstrapAddrs checks the merge of DNS results and fixed seeds.
+func TestBootstrapAddrs(t *testing.T) {
+	params := Params{
+		Name: "bootstraptest",
+		FixedSeeds: []netip.AddrPort{
+			netip.MustParseAddrPort("192.0.2.2:8333"),
+			netip.MustParseAddrPort("198.51.100.1:8333"),
+		},
+	}
+
+	addrs, err := params.BootstrapAddrs([]netip.AddrPort{
+		netip.MustParseAddrPort("192.0.2.1:8333"),
+		netip.MustParseAddrPort("[::ffff:192.0.2.2]:8333"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []netip.AddrPort{
+		netip.MustParseAddrPort("192.0.2.1:8333"),
+		netip.MustParseAddrPort("192.0.2.2:8333"),
+		netip.MustParseAddrPort("198.51.100.1:8333"),
+	}, addrs)
+
+	// Without DNS results the fixed seeds are used on their own.
+	addrs, err = params.BootstrapAddrs(nil)
+	require.NoError(t, err)
+	assert.Equal(t, params.FixedSeeds, addrs)
+
+	params.FixedSeeds = nil
+
+	_, err = params.BootstrapAddrs(nil)
+	require.ErrorIs(t, err, ErrNoBootstrapAddrs)
+}
+
+// TestFixedSeedsValid checks the generated fixed seed lists.
+func TestFixedSeedsValid(t *testing.T) {
+	for _, p := range builtinNets {
+		seen := make(map[netip.AddrPort]struct{})
+
+		for _, s := range p.FixedSeeds {
+			assert.True(t, s.IsValid(), "%s: %s", p.Name, s)
+			assert.NotZero(t, s.Port(), "%s: %s", p.Name, s)
+			assert.True(t, s.Addr().IsGlobalUnicast() && !s.Addr().IsPrivate(), "%s: %s", p.Name, s)
+
+			_, dup := seen[s]
+			assert.False(t, dup, "%s: duplicate %s", p.Name, s)
+
+			seen[s] = struct{}{}
+		}
+	}
+}
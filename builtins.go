@@ -0,0 +1,185 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// ErrBuiltinModified describes an error where a built-in network variable
+// no longer matches the values it had when the package was initialized.
+var ErrBuiltinModified = errors.New("built-in network parameters modified")
+
+// builtinSnapshots holds deep copies of the built-in networks taken during
+// package initialization, in builtinNets order.
+var builtinSnapshots = snapshotBuiltins()
+
+// snapshotBuiltins deep copies every built-in network.
+func snapshotBuiltins() []*Params {
+	snapshots := make([]*Params, len(builtinNets))
+	for i, p := range builtinNets {
+		snapshots[i] = p.Clone()
+	}
+
+	return snapshots
+}
+
+// MainNet returns a deep copy of the main network parameters as defined by
+// this package, unaffected by any changes made to MainNetParams.
+func MainNet() *Params {
+	return builtinSnapshot(&MainNetParams)
+}
+
+// TestNet returns a deep copy of the test network parameters as defined by
+// this package, unaffected by any changes made to TestNetParams.
+func TestNet() *Params {
+	return builtinSnapshot(&TestNetParams)
+}
+
+// RegressionNet returns a deep copy of the regression test network
+// parameters as defined by this package, unaffected by any changes made to
+// RegressionNetParams.
+func RegressionNet() *Params {
+	return builtinSnapshot(&RegressionNetParams)
+}
+
+// Stn returns a deep copy of the scaling test network parameters as defined
+// by this package, unaffected by any changes made to StnParams.
+func Stn() *Params {
+	return builtinSnapshot(&StnParams)
+}
+
+// TeraTestNet returns a deep copy of the Teranode test network parameters as
+// defined by this package, unaffected by any changes made to
+// TeraTestNetParams.
+func TeraTestNet() *Params {
+	return builtinSnapshot(&TeraTestNetParams)
+}
+
+// TeraScalingTestNet returns a deep copy of the Teranode scaling test network
+// parameters as defined by this package, unaffected by any changes made to
+// TeraScalingTestNetParams.
+func TeraScalingTestNet() *Params {
+	return builtinSnapshot(&TeraScalingTestNetParams)
+}
+
+// builtinSnapshot returns a fresh copy of the init-time snapshot of the given
+// built-in network.
+func builtinSnapshot(p *Params) *Params {
+	return builtinSnapshots[slices.Index(builtinNets, p)].Clone()
+}
+
+// VerifyBuiltinsUnmodified checks that no built-in network variable has been
+// changed since package initialization.
+//
+// The exported variables such as MainNetParams are mutable, so any importer
+// can alter them for the whole process.  Call this from tests or at node
+// startup to catch that.  Every field is compared, including the values
+// behind pointers and slices.
+//
+// Returns:
+//
+//	error - nil when unmodified, otherwise ErrBuiltinModified listing each changed field.
+func VerifyBuiltinsUnmodified() error {
+	var modified []string
+
+	for i, p := range builtinNets {
+		for _, c := range Diff(builtinSnapshots[i], p) {
+			modified = append(modified, builtinSnapshots[i].Name+"."+c.Field)
+		}
+	}
+
+	if len(modified) > 0 {
+		return fmt.Errorf("%w: %s", ErrBuiltinModified, strings.Join(modified, ", "))
+	}
+
+	return nil
+}
+
+// Clone returns a deep copy of the parameters.  The copy shares no mutable
+// state with p, so it may be changed freely.
+func (p *Params) Clone() *Params {
+	c := *p
+
+	c.Aliases = slices.Clone(p.Aliases)
+	c.ProtocolVersions = slices.Clone(p.ProtocolVersions)
+	c.DNSSeeds = slices.Clone(p.DNSSeeds)
+	c.FixedSeeds = slices.Clone(p.FixedSeeds)
+
+	if p.BootstrapPeers != nil {
+		c.BootstrapPeers = make([]multiaddr.Multiaddr, len(p.BootstrapPeers))
+		for i, m := range p.BootstrapPeers {
+			c.BootstrapPeers[i] = slices.Clone(m)
+		}
+	}
+
+	c.GenesisBlock = cloneBlock(p.GenesisBlock)
+	c.GenesisHash = cloneHash(p.GenesisHash)
+
+	if p.PowLimit != nil {
+		c.PowLimit = new(big.Int).Set(p.PowLimit)
+	}
+
+	c.Checkpoints = cloneCheckpoints(p.Checkpoints)
+	c.BIP30Exceptions = cloneCheckpoints(p.BIP30Exceptions)
+	c.InvalidBlocks = cloneCheckpoints(p.InvalidBlocks)
+	c.RequiredAncestors = cloneCheckpoints(p.RequiredAncestors)
+
+	if p.ActivationBlocks != nil {
+		c.ActivationBlocks = make(map[Upgrade]Checkpoint, len(p.ActivationBlocks))
+		for u, cp := range p.ActivationBlocks {
+			c.ActivationBlocks[u] = Checkpoint{Height: cp.Height, Hash: cloneHash(cp.Hash)}
+		}
+	}
+
+	return &c
+}
+
+// cloneCheckpoints returns a deep copy of checkpoints, or nil.
+func cloneCheckpoints(checkpoints []Checkpoint) []Checkpoint {
+	if checkpoints == nil {
+		return nil
+	}
+
+	c := make([]Checkpoint, len(checkpoints))
+	for i, cp := range checkpoints {
+		c[i] = Checkpoint{Height: cp.Height, Hash: cloneHash(cp.Hash)}
+	}
+
+	return c
+}
+
+// cloneHash returns a copy of h, or nil.
+func cloneHash(h *chainhash.Hash) *chainhash.Hash {
+	if h == nil {
+		return nil
+	}
+
+	c := *h
+
+	return &c
+}
+
+// cloneBlock returns a deep copy of b, or nil.
+func cloneBlock(b *wire.MsgBlock) *wire.MsgBlock {
+	if b == nil {
+		return nil
+	}
+
+	c := &wire.MsgBlock{Header: b.Header}
+
+	if b.Transactions != nil {
+		c.Transactions = make([]*wire.MsgTx, len(b.Transactions))
+		for i, tx := range b.Transactions {
+			c.Transactions[i] = tx.Copy()
+		}
+	}
+
+	return c
+}
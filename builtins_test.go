@@ -0,0 +1,95 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBuiltinAccessors ensures the accessors return independent copies equal
+// to the built-in variables.
+func TestBuiltinAccessors(t *testing.T) {
+	accessors := map[*Params]func() *Params{
+		&MainNetParams:            MainNet,
+		&TestNetParams:            TestNet,
+		&RegressionNetParams:      RegressionNet,
+		&StnParams:                Stn,
+		&TeraTestNetParams:        TeraTestNet,
+		&TeraScalingTestNetParams: TeraScalingTestNet,
+	}
+	require.Len(t, accessors, len(builtinNets))
+
+	for builtin, accessor := range accessors {
+		p := accessor()
+		assert.NotSame(t, builtin, p)
+		assert.Empty(t, Diff(builtin, p), builtin.Name)
+
+		// Mutating the copy must leave the built-in and later copies intact.
+		p.CoinbaseMaturity++
+		p.PowLimit.SetInt64(1)
+		p.GenesisHash[0]++
+		p.GenesisBlock.Header.Nonce++
+		p.GenesisBlock.Transactions[0].LockTime++
+		p.Checkpoints = append(p.Checkpoints, Checkpoint{Height: 1})
+
+		if len(p.DNSSeeds) > 0 {
+			p.DNSSeeds[0].Host = "changed"
+		}
+
+		p.BootstrapPeers = append(p.BootstrapPeers, multiaddr.StringCast("/ip4/192.0.2.1/tcp/9905"))
+
+		assert.Empty(t, Diff(builtin, accessor()), builtin.Name)
+	}
+
+	require.NoError(t, VerifyBuiltinsUnmodified())
+}
+
+// TestVerifyBuiltinsUnmodified checks that changes to the exported variables
+// are detected and listed.
+func TestVerifyBuiltinsUnmodified(t *testing.T) {
+	require.NoError(t, VerifyBuiltinsUnmodified())
+
+	orig := MainNetParams.CoinbaseMaturity
+	MainNetParams.CoinbaseMaturity = 1
+
+	err := VerifyBuiltinsUnmodified()
+	require.ErrorIs(t, err, ErrBuiltinModified)
+	assert.Contains(t, err.Error(), "mainnet.CoinbaseMaturity")
+
+	// The accessor still returns the original value.
+	assert.Equal(t, orig, MainNet().CoinbaseMaturity)
+
+	MainNetParams.CoinbaseMaturity = orig
+	require.NoError(t, VerifyBuiltinsUnmodified())
+
+	// A change behind a pointer is detected too.
+	TestNetParams.PowLimit.Add(TestNetParams.PowLimit, bigOne)
+
+	err = VerifyBuiltinsUnmodified()
+	require.ErrorIs(t, err, ErrBuiltinModified)
+	assert.Contains(t, err.Error(), "testnet.PowLimit")
+
+	TestNetParams.PowLimit.Sub(TestNetParams.PowLimit, bigOne)
+	require.NoError(t, VerifyBuiltinsUnmodified())
+}
+
+// TestCloneBootstrapPeers checks that Clone copies the bootstrap peer list and
+// each address within it.
+func TestCloneBootstrapPeers(t *testing.T) {
+	p := &Params{BootstrapPeers: []multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip4/192.0.2.1/tcp/9905"),
+		multiaddr.StringCast("/ip4/192.0.2.2/tcp/9905"),
+	}}
+
+	c := p.Clone()
+	require.Empty(t, Diff(p, c))
+
+	c.BootstrapPeers[0] = multiaddr.StringCast("/ip4/198.51.100.1/tcp/9905")
+	c.BootstrapPeers[1][0] = c.BootstrapPeers[0][0]
+
+	assert.Equal(t, "/ip4/192.0.2.1/tcp/9905", p.BootstrapPeers[0].String())
+	assert.Equal(t, "/ip4/192.0.2.2/tcp/9905", p.BootstrapPeers[1].String())
+	assert.NotEmpty(t, Diff(p, c))
+}
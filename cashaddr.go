@@ -0,0 +1,334 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CashAddressType identifies the kind of hash carried by a cashaddress.
+type CashAddressType byte
+
+const (
+	// CashAddressP2PKH identifies a pay-to-public-key-hash cashaddress.
+	CashAddressP2PKH CashAddressType = 0
+
+	// CashAddressP2SH identifies a pay-to-script-hash cashaddress.
+	CashAddressP2SH CashAddressType = 1
+)
+
+var (
+	// ErrNoCashAddressPrefix describes an error where a cashaddress was
+	// requested for a network that does not define a cashaddress prefix.
+	ErrNoCashAddressPrefix = errors.New("network has no cashaddress prefix")
+
+	// ErrUnknownCashAddressPrefix describes an error where the prefix of a
+	// cashaddress does not belong to any known network.
+	ErrUnknownCashAddressPrefix = errors.New("unknown cashaddress prefix")
+
+	// ErrInvalidCashAddress describes an error where a cashaddress string is
+	// malformed: mixed case, bad characters, bad padding or bad length.
+	ErrInvalidCashAddress = errors.New("invalid cashaddress")
+
+	// ErrCashAddressChecksum describes an error where the checksum of a
+	// cashaddress does not match its prefix and payload.
+	ErrCashAddressChecksum = errors.New("invalid cashaddress checksum")
+
+	// ErrInvalidCashAddressHashSize describes an error where the hash carried
+	// by a cashaddress has a length the version byte cannot express.
+	ErrInvalidCashAddressHashSize = errors.New("invalid cashaddress hash size")
+
+	// ErrInvalidCashAddressType describes an error where an address type
+	// does not fit in the four type bits of the version byte.
+	ErrInvalidCashAddressType = errors.New("invalid cashaddress type")
+)
+
+// cashAddressCharset is the base32 alphabet used by cashaddress payloads.
+const cashAddressCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// cashAddressChecksumLen is the number of 5-bit groups in the checksum.
+const cashAddressChecksumLen = 8
+
+// cashAddressHashSizes maps the size bits of the version byte to hash lengths
+// in bytes.
+var cashAddressHashSizes = [8]int{20, 24, 28, 32, 40, 48, 56, 64}
+
+// CashAddress is a decoded cashaddress.
+type CashAddress struct {
+	// Params is the network the address belongs to.
+	Params *Params
+
+	// Type is the kind of hash carried by the address.
+	Type CashAddressType
+
+	// Hash is the public key hash or script hash.
+	Hash []byte
+}
+
+// String returns the lowercase cashaddress, including the prefix.
+func (a *CashAddress) String() string {
+	s, err := EncodeCashAddress(a.Params, a.Type, a.Hash)
+	if err != nil {
+		return ""
+	}
+
+	return s
+}
+
+// EncodeCashAddress encodes a hash as a lowercase cashaddress for the given network.
+//
+// Parameters:
+//
+//	params - the network whose CashAddressPrefix is used.
+//	addrType - the kind of hash being encoded.
+//	hash - the public key hash or script hash; its length must be one the version byte can express.
+//
+// Returns:
+//
+//	string - the cashaddress, including the prefix and ':' separator.
+//	error - ErrNoCashAddressPrefix if the network has no prefix, ErrInvalidCashAddressType,
+//	        or ErrInvalidCashAddressHashSize.
+func EncodeCashAddress(params *Params, addrType CashAddressType, hash []byte) (string, error) {
+	if params == nil || params.CashAddressPrefix == "" {
+		return "", ErrNoCashAddressPrefix
+	}
+
+	if addrType > 0x0f {
+		return "", fmt.Errorf("%w: %d", ErrInvalidCashAddressType, addrType)
+	}
+
+	sizeBits := -1
+
+	for i, n := range cashAddressHashSizes {
+		if n == len(hash) {
+			sizeBits = i
+			break
+		}
+	}
+
+	if sizeBits < 0 {
+		return "", fmt.Errorf("%w: %d bytes", ErrInvalidCashAddressHashSize, len(hash))
+	}
+
+	prefix := strings.ToLower(params.CashAddressPrefix)
+
+	payload := make([]byte, 0, len(hash)+1)
+	payload = append(payload, byte(addrType)<<3|byte(sizeBits))
+	payload = append(payload, hash...)
+
+	data := convertBits(payload, 8, 5, true)
+	checksum := cashAddressChecksum(prefix, data)
+
+	var sb strings.Builder
+
+	sb.Grow(len(prefix) + 1 + len(data) + len(checksum))
+	sb.WriteString(prefix)
+	sb.WriteByte(':')
+
+	for _, d := range append(data, checksum...) {
+		sb.WriteByte(cashAddressCharset[d])
+	}
+
+	return sb.String(), nil
+}
+
+// DecodeCashAddress parses a cashaddress and resolves the network from its prefix.
+//
+// The prefix is matched case-insensitively against every built-in and
+// registered network.  Several networks may share a prefix (testnet,
+// teratestnet and tstn all use "bsvtest"); in that case defaultParams is
+// preferred when it matches, otherwise the first network in lookup order is
+// returned.  An address without a prefix is checked against the prefix of
+// defaultParams.
+//
+// Parameters:
+//
+//	addr - the cashaddress, with or without its prefix, in all lower or all upper case.
+//	defaultParams - the network assumed for prefix-less input and preferred on ambiguity; may be nil.
+//
+// Returns:
+//
+//	*CashAddress - the decoded address.
+//	error - ErrInvalidCashAddress, ErrCashAddressChecksum, ErrUnknownCashAddressPrefix,
+//	        ErrNoCashAddressPrefix or ErrInvalidCashAddressHashSize on failure.
+func DecodeCashAddress(addr string, defaultParams *Params) (*CashAddress, error) {
+	if strings.ToLower(addr) != addr && strings.ToUpper(addr) != addr {
+		return nil, fmt.Errorf("%w: mixed case", ErrInvalidCashAddress)
+	}
+
+	addr = strings.ToLower(addr)
+
+	var (
+		prefix  string
+		encoded string
+		params  *Params
+	)
+
+	if i := strings.LastIndexByte(addr, ':'); i >= 0 {
+		prefix, encoded = addr[:i], addr[i+1:]
+		if prefix == "" {
+			return nil, fmt.Errorf("%w: empty prefix", ErrInvalidCashAddress)
+		}
+
+		params = paramsForCashAddressPrefix(prefix, defaultParams)
+		if params == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCashAddressPrefix, prefix)
+		}
+	} else {
+		if defaultParams == nil || defaultParams.CashAddressPrefix == "" {
+			return nil, ErrNoCashAddressPrefix
+		}
+
+		prefix, encoded, params = strings.ToLower(defaultParams.CashAddressPrefix), addr, defaultParams
+	}
+
+	if len(encoded) <= cashAddressChecksumLen {
+		return nil, fmt.Errorf("%w: too short", ErrInvalidCashAddress)
+	}
+
+	data := make([]byte, len(encoded))
+
+	for i := 0; i < len(encoded); i++ {
+		d := strings.IndexByte(cashAddressCharset, encoded[i])
+		if d < 0 {
+			return nil, fmt.Errorf("%w: bad character %q", ErrInvalidCashAddress, encoded[i])
+		}
+
+		data[i] = byte(d)
+	}
+
+	if cashAddressPolymod(append(expandCashAddressPrefix(prefix), data...)) != 0 {
+		return nil, ErrCashAddressChecksum
+	}
+
+	payload := convertBits(data[:len(data)-cashAddressChecksumLen], 5, 8, false)
+	if len(payload) == 0 {
+		return nil, fmt.Errorf("%w: bad padding", ErrInvalidCashAddress)
+	}
+
+	version, hash := payload[0], payload[1:]
+	if version&0x80 != 0 {
+		return nil, fmt.Errorf("%w: reserved version bit set", ErrInvalidCashAddress)
+	}
+
+	if cashAddressHashSizes[version&0x07] != len(hash) {
+		return nil, fmt.Errorf("%w: %d bytes", ErrInvalidCashAddressHashSize, len(hash))
+	}
+
+	return &CashAddress{
+		Params: params,
+		Type:   CashAddressType(version >> 3),
+		Hash:   hash,
+	}, nil
+}
+
+// paramsForCashAddressPrefix returns the network using the lowercase prefix,
+// preferring preferred when it matches.
+func paramsForCashAddressPrefix(prefix string, preferred *Params) *Params {
+	if preferred != nil && strings.EqualFold(preferred.CashAddressPrefix, prefix) {
+		return preferred
+	}
+
+	for _, p := range knownNets() {
+		if p.CashAddressPrefix != "" && strings.EqualFold(p.CashAddressPrefix, prefix) {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// cashAddressChecksum returns the eight 5-bit checksum groups for the given
+// lowercase prefix and 5-bit payload.
+func cashAddressChecksum(prefix string, data []byte) []byte {
+	values := make([]byte, 0, len(prefix)+1+len(data)+cashAddressChecksumLen)
+	values = append(values, expandCashAddressPrefix(prefix)...)
+	values = append(values, data...)
+	values = append(values, make([]byte, cashAddressChecksumLen)...)
+
+	mod := cashAddressPolymod(values)
+
+	checksum := make([]byte, cashAddressChecksumLen)
+	for i := range checksum {
+		checksum[i] = byte((mod >> (5 * (7 - i))) & 0x1f)
+	}
+
+	return checksum
+}
+
+// expandCashAddressPrefix returns the lower five bits of each prefix character
+// followed by the zero separator, as required by the checksum.
+func expandCashAddressPrefix(prefix string) []byte {
+	out := make([]byte, len(prefix)+1)
+	for i := 0; i < len(prefix); i++ {
+		out[i] = prefix[i] & 0x1f
+	}
+
+	return out
+}
+
+// cashAddressPolymod computes the BCH code checksum defined by the cashaddress
+// specification over 5-bit values.
+func cashAddressPolymod(values []byte) uint64 {
+	c := uint64(1)
+
+	for _, d := range values {
+		c0 := byte(c >> 35)
+		c = ((c & 0x07ffffffff) << 5) ^ uint64(d)
+
+		if c0&0x01 != 0 {
+			c ^= 0x98f2bc8e61
+		}
+
+		if c0&0x02 != 0 {
+			c ^= 0x79b76d99e2
+		}
+
+		if c0&0x04 != 0 {
+			c ^= 0xf33e5fb3c4
+		}
+
+		if c0&0x08 != 0 {
+			c ^= 0xae2eabe2a8
+		}
+
+		if c0&0x10 != 0 {
+			c ^= 0x1e4f43e470
+		}
+	}
+
+	return c ^ 1
+}
+
+// convertBits regroups data from fromBits-wide values into toBits-wide values.
+// When pad is false, leftover bits must be zero and fewer than fromBits,
+// otherwise nil is returned.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) []byte {
+	var (
+		acc  uint32
+		bits uint
+	)
+
+	maxv := uint32(1)<<toBits - 1
+	out := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+
+	for _, v := range data {
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte((acc>>bits)&maxv))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			out = append(out, byte((acc<<(toBits-bits))&maxv))
+		}
+	} else if bits >= fromBits || (acc<<(toBits-bits))&maxv != 0 {
+		return nil
+	}
+
+	return out
+}
@@ -0,0 +1,107 @@
+package chaincfg
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCashAddressVectors checks encoding and decoding against the reference
+// vectors from the cashaddress specification.
+func TestCashAddressVectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     string
+		addrType CashAddressType
+		hash     string
+	}{
+		{"p2pkh", "bitcoincash:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2", CashAddressP2PKH, "f5bf48b397dae70be82b3cca4793f8eb2b6cdac9"},
+		{"p2sh", "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq", CashAddressP2SH, "76a04053bda0a88bda5177b86a15c3b29f559873"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hash, err := hex.DecodeString(tc.hash)
+			require.NoError(t, err)
+
+			encoded, err := EncodeCashAddress(&MainNetParams, tc.addrType, hash)
+			require.NoError(t, err)
+			assert.Equal(t, tc.addr, encoded)
+
+			for _, in := range []string{tc.addr, strings.ToUpper(tc.addr), strings.TrimPrefix(tc.addr, "bitcoincash:")} {
+				decoded, err := DecodeCashAddress(in, &MainNetParams)
+				require.NoError(t, err, in)
+				assert.Same(t, &MainNetParams, decoded.Params)
+				assert.Equal(t, tc.addrType, decoded.Type)
+				assert.Equal(t, hash, decoded.Hash)
+				assert.Equal(t, tc.addr, decoded.String())
+			}
+		})
+	}
+}
+
+// TestCashAddressNetworks checks prefix resolution through the registry.
+func TestCashAddressNetworks(t *testing.T) {
+	hash := make([]byte, 20)
+
+	for _, p := range []*Params{&MainNetParams, &TestNetParams, &RegressionNetParams} {
+		addr, err := EncodeCashAddress(p, CashAddressP2PKH, hash)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(addr, p.CashAddressPrefix+":"))
+
+		decoded, err := DecodeCashAddress(addr, nil)
+		require.NoError(t, err)
+		assert.Same(t, p, decoded.Params)
+	}
+
+	// teratestnet shares the bsvtest prefix and is preferred when supplied.
+	addr, err := EncodeCashAddress(&TeraTestNetParams, CashAddressP2PKH, hash)
+	require.NoError(t, err)
+
+	decoded, err := DecodeCashAddress(addr, &TeraTestNetParams)
+	require.NoError(t, err)
+	assert.Same(t, &TeraTestNetParams, decoded.Params)
+
+	_, err = EncodeCashAddress(&StnParams, CashAddressP2PKH, hash)
+	require.ErrorIs(t, err, ErrNoCashAddressPrefix)
+
+	_, err = DecodeCashAddress(strings.TrimPrefix(addr, "bsvtest:"), &StnParams)
+	require.ErrorIs(t, err, ErrNoCashAddressPrefix)
+
+	assert.False(t, IsCashAddressPrefix(StnParams.Net, ":"))
+}
+
+// TestDecodeCashAddressErrors checks that malformed addresses are rejected.
+func TestDecodeCashAddressErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		wantErr error
+	}{
+		{"mixed case", "bitcoincash:Qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2", ErrInvalidCashAddress},
+		{"bad checksum", "bitcoincash:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg3", ErrCashAddressChecksum},
+		{"wrong prefix", "bsvtest:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2", ErrCashAddressChecksum},
+		{"unknown prefix", "bchtest:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2", ErrUnknownCashAddressPrefix},
+		{"bad character", "bitcoincash:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekgb", ErrInvalidCashAddress},
+		{"too short", "bitcoincash:qpzry9x8", ErrInvalidCashAddress},
+		{"empty prefix", ":qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2", ErrInvalidCashAddress},
+		{"no default network", "qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2", ErrNoCashAddressPrefix},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := DecodeCashAddress(tc.addr, nil)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+
+	_, err := EncodeCashAddress(&MainNetParams, CashAddressP2PKH, make([]byte, 21))
+	require.ErrorIs(t, err, ErrInvalidCashAddressHashSize)
+
+	_, err = EncodeCashAddress(&MainNetParams, CashAddressType(0x10), make([]byte, 20))
+	require.ErrorIs(t, err, ErrInvalidCashAddressType)
+	require.NotErrorIs(t, err, ErrInvalidCashAddressHashSize)
+}
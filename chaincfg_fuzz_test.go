@@ -1,6 +1,7 @@
 package chaincfg
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -54,9 +55,10 @@ func validateResult(t *testing.T, network string, params *Params, err error) {
 	}
 }
 
-// getExpectedParams returns the expected Params for known networks
+// getExpectedParams returns the expected Params for known networks, whose
+// names are matched case-insensitively.
 func getExpectedParams(network string) *Params {
-	switch network {
+	switch strings.ToLower(network) {
 	case "mainnet":
 		return &MainNetParams
 	case "testnet":
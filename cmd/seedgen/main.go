@@ -0,0 +1,179 @@
+// Command seedgen builds fixedseeds.go, the hard-coded fallback peer list used
+// when a network's DNS seeds fail or are absent.
+//
+// It is modelled on bitcoind's contrib/seeds tooling: each network has a plain
+// text list of nodes (nodes/<network>.txt) holding one IPv4 or [IPv6] address
+// per line with an optional :port.  Entries are validated, given the network's
+// DefaultPort when no port is present, de-duplicated and sorted so the output
+// is stable across runs.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var (
+	errNotRoutable = errors.New("address is not a routable unicast address")
+	errZeroPort    = errors.New("port must not be zero")
+)
+
+// network ties a node list to the generated variable that holds it.  The
+// default port mirrors Params.DefaultPort; it is repeated here so the
+// generator does not depend on the package it generates.
+type network struct {
+	name        string
+	varName     string
+	defaultPort uint16
+}
+
+// networks lists the generated variables in output order.
+var networks = []network{
+	{"mainnet", "mainNetFixedSeeds", 8333},
+	{"testnet", "testNetFixedSeeds", 18333},
+	{"regtest", "regressionNetFixedSeeds", 18444},
+	{"stn", "stnFixedSeeds", 9333},
+	{"teratestnet", "teraTestNetFixedSeeds", 18333},
+	{"tstn", "teraScalingTestNetFixedSeeds", 18333},
+}
+
+func main() {
+	if err := run(); err != nil {
+		writef(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	dir := flag.String("dir", "cmd/seedgen/nodes", "directory holding one <network>.txt node list per network")
+	out := flag.String("out", "fixedseeds.go", "path of the generated Go file")
+
+	flag.Parse()
+
+	var buf bytes.Buffer
+
+	writef(&buf, "// Code generated by seedgen from %s. DO NOT EDIT.\n\n", filepath.ToSlash(*dir))
+	writef(&buf, "package chaincfg\n\nimport \"net/netip\"\n")
+
+	for _, n := range networks {
+		seeds, err := readNodes(filepath.Join(*dir, n.name+".txt"), n.defaultPort)
+		if err != nil {
+			return err
+		}
+
+		writef(&buf, "\n// %s are the fixed seed nodes for %s.\nvar %s = []netip.AddrPort{\n", n.varName, n.name, n.varName)
+
+		for _, s := range seeds {
+			writef(&buf, "\tnetip.MustParseAddrPort(%q),\n", s.String())
+		}
+
+		writef(&buf, "}\n")
+
+		writef(os.Stderr, "%s: %d fixed seeds\n", n.name, len(seeds))
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format generated source: %w", err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", *out, err)
+	}
+
+	return nil
+}
+
+// readNodes parses the node list at path, applying defaultPort where an entry
+// has none, and returns the sorted, de-duplicated addresses.
+func readNodes(path string, defaultPort uint16) ([]netip.AddrPort, error) {
+	f, err := os.Open(path) //nolint:gosec // path is an operator-supplied generator input
+	if err != nil {
+		return nil, fmt.Errorf("failed to open node list: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	return parseNodes(f, path, defaultPort)
+}
+
+// parseNodes reads one address per line from r.  name is used in errors.
+func parseNodes(r io.Reader, name string, defaultPort uint16) ([]netip.AddrPort, error) {
+	var seeds []netip.AddrPort
+
+	scanner := bufio.NewScanner(r)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = strings.TrimSpace(text[:i])
+		}
+
+		if text == "" {
+			continue
+		}
+
+		seed, err := parseNode(text, defaultPort)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %q: %w", name, line, text, err)
+		}
+
+		seeds = append(seeds, seed)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	slices.SortFunc(seeds, func(a, b netip.AddrPort) int { return a.Compare(b) })
+
+	return slices.Compact(seeds), nil
+}
+
+// parseNode parses a single IPv4, [IPv6] or bare IPv6 entry with an optional
+// port.  Only routable public unicast addresses are accepted.
+func parseNode(text string, defaultPort uint16) (netip.AddrPort, error) {
+	var (
+		seed netip.AddrPort
+		err  error
+	)
+
+	bare := text
+	if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+		bare = text[1 : len(text)-1]
+	}
+
+	if addr, perr := netip.ParseAddr(bare); perr == nil {
+		seed = netip.AddrPortFrom(addr, defaultPort)
+	} else if seed, err = netip.ParseAddrPort(text); err != nil {
+		return netip.AddrPort{}, err
+	}
+
+	addr := seed.Addr().Unmap()
+
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return netip.AddrPort{}, errNotRoutable
+	}
+
+	if seed.Port() == 0 {
+		return netip.AddrPort{}, errZeroPort
+	}
+
+	return netip.AddrPortFrom(addr, seed.Port()), nil
+}
+
+// writef writes formatted output to w, ignoring the (always nil for these
+// destinations) write error.
+func writef(w io.Writer, format string, a ...any) {
+	_, _ = fmt.Fprintf(w, format, a...)
+}
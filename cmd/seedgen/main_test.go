@@ -0,0 +1,78 @@
+package main
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseNode checks address forms, default ports and address validation.
+func TestParseNode(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    string
+		wantErr error
+	}{
+		{"8.8.8.8", "8.8.8.8:8333", nil},
+		{"8.8.8.8:9000", "8.8.8.8:9000", nil},
+		{"2001:4860::8888", "[2001:4860::8888]:8333", nil},
+		{"[2001:4860::8888]", "[2001:4860::8888]:8333", nil},
+		{"[2001:4860::8888]:9000", "[2001:4860::8888]:9000", nil},
+		{"::ffff:8.8.8.8", "8.8.8.8:8333", nil},
+		{"10.0.0.1", "", errNotRoutable},
+		{"192.168.1.1", "", errNotRoutable},
+		{"127.0.0.1", "", errNotRoutable},
+		{"0.0.0.0", "", errNotRoutable},
+		{"224.0.0.1", "", errNotRoutable},
+		{"::1", "", errNotRoutable},
+		{"fd00::1", "", errNotRoutable},
+		{"8.8.8.8:0", "", errZeroPort},
+	}
+
+	for _, tc := range tests {
+		got, err := parseNode(tc.text, 8333)
+		if tc.wantErr != nil {
+			require.ErrorIs(t, err, tc.wantErr, tc.text)
+			continue
+		}
+
+		require.NoError(t, err, tc.text)
+		assert.Equal(t, tc.want, got.String(), tc.text)
+	}
+
+	for _, text := range []string{"seed.example.com", "8.8.8.8:port", "8.8.8.8:70000", "[::1"} {
+		_, err := parseNode(text, 8333)
+		require.Error(t, err, text)
+	}
+}
+
+// TestParseNodes checks comments, sorting, de-duplication and error lines.
+func TestParseNodes(t *testing.T) {
+	list := `# header comment
+
+8.8.8.8
+1.1.1.1:8333   # same port as the default
+8.8.8.8:8333
+[2001:4860::8888]
+::ffff:1.1.1.1
+`
+
+	seeds, err := parseNodes(strings.NewReader(list), "test.txt", 8333)
+	require.NoError(t, err)
+	assert.Equal(t, []netip.AddrPort{
+		netip.MustParseAddrPort("1.1.1.1:8333"),
+		netip.MustParseAddrPort("8.8.8.8:8333"),
+		netip.MustParseAddrPort("[2001:4860::8888]:8333"),
+	}, seeds)
+
+	seeds, err = parseNodes(strings.NewReader("# empty\n"), "test.txt", 8333)
+	require.NoError(t, err)
+	assert.Empty(t, seeds)
+
+	_, err = parseNodes(strings.NewReader("8.8.8.8\n10.0.0.1\n"), "test.txt", 8333)
+	require.ErrorIs(t, err, errNotRoutable)
+	assert.Contains(t, err.Error(), "test.txt:2:")
+}
@@ -0,0 +1,180 @@
+package chaincfg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/bscript"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// MinCoinbaseScriptSigSize is the minimum size in bytes of a coinbase
+// scriptSig.
+const MinCoinbaseScriptSigSize = 2
+
+// maxCoinbaseHeightLen is the longest minimal script number push needed for
+// a non-negative int32 height: four bytes plus a sign byte.
+const maxCoinbaseHeightLen = 5
+
+var (
+	// ErrInvalidCoinbase describes an error where a coinbase transaction does
+	// not have the shape of a coinbase: a single input spending the null
+	// outpoint and at least one output.
+	ErrInvalidCoinbase = errors.New("invalid coinbase transaction")
+
+	// ErrCoinbaseScriptSigSize describes an error where a coinbase scriptSig
+	// is shorter than MinCoinbaseScriptSigSize or longer than the network's
+	// MaxCoinbaseScriptSigSize.
+	ErrCoinbaseScriptSigSize = errors.New("coinbase scriptSig size out of range")
+
+	// ErrCoinbaseHeight describes an error where a coinbase scriptSig does
+	// not start with the block height serialized as required by BIP0034.
+	ErrCoinbaseHeight = errors.New("coinbase height mismatch")
+)
+
+// EncodeCoinbaseHeight returns the BIP0034 serialization of a block height:
+// the minimal script push of the height as a script number, which a coinbase
+// scriptSig must start with once BIP0034 is active.
+//
+// Heights 0 to 16 are encoded as the opcodes OP_0 to OP_16; larger heights
+// as a data push of the little-endian value, with an extra zero byte when the
+// top bit would otherwise mark it negative.  Negative heights encode as nil.
+func EncodeCoinbaseHeight(height int32) []byte {
+	switch {
+	case height < 0:
+		return nil
+	case height == 0:
+		return []byte{bscript.Op0}
+	case height <= 16:
+		return []byte{bscript.Op1 + byte(height-1)}
+	}
+
+	var num []byte
+	for h := uint32(height); h > 0; h >>= 8 {
+		num = append(num, byte(h))
+	}
+
+	if num[len(num)-1]&0x80 != 0 {
+		num = append(num, 0)
+	}
+
+	return append([]byte{byte(len(num))}, num...)
+}
+
+// DecodeCoinbaseHeight reads the BIP0034 block height from the start of a
+// coinbase scriptSig.
+//
+// The height must be minimally encoded and non-negative.  Coinbases mined
+// before BIP0034 activated often start with other pushes, which decode as
+// meaningless heights or fail, so only trust the result once the network's
+// BIP0034Height is reached.
+//
+// Parameters:
+//
+//	scriptSig - the coinbase unlocking script.
+//
+// Returns:
+//
+//	int32 - the encoded height.
+//	error - ErrCoinbaseHeight if the script does not start with a valid height.
+func DecodeCoinbaseHeight(scriptSig []byte) (int32, error) {
+	if len(scriptSig) == 0 {
+		return 0, fmt.Errorf("%w: empty scriptSig", ErrCoinbaseHeight)
+	}
+
+	op := scriptSig[0]
+
+	switch {
+	case op == bscript.Op0:
+		return 0, nil
+	case op >= bscript.Op1 && op <= bscript.Op16:
+		return int32(op-bscript.Op1) + 1, nil
+	case op < bscript.OpDATA1 || op > maxCoinbaseHeightLen:
+		return 0, fmt.Errorf("%w: scriptSig does not start with a height push", ErrCoinbaseHeight)
+	case len(scriptSig) < 1+int(op):
+		return 0, fmt.Errorf("%w: truncated height push", ErrCoinbaseHeight)
+	}
+
+	num := scriptSig[1 : 1+op]
+
+	var height uint64
+	for i := len(num) - 1; i >= 0; i-- {
+		height = height<<8 | uint64(num[i])
+	}
+
+	if num[len(num)-1]&0x80 != 0 {
+		return 0, fmt.Errorf("%w: negative height", ErrCoinbaseHeight)
+	}
+
+	if height > math.MaxInt32 || !bytes.Equal(EncodeCoinbaseHeight(int32(height)), scriptSig[:1+op]) {
+		return 0, fmt.Errorf("%w: height %x is not minimally encoded", ErrCoinbaseHeight, num)
+	}
+
+	return int32(height), nil
+}
+
+// ValidateCoinbase checks the coinbase transaction of the block at the given
+// height against the network's coinbase rules.
+//
+// The transaction must have a single input spending the null outpoint and at
+// least one output, and its scriptSig must be between
+// MinCoinbaseScriptSigSize and MaxCoinbaseScriptSigSize bytes.  From
+// BIP0034Height on, the scriptSig must start with EncodeCoinbaseHeight(height).
+//
+// Parameters:
+//
+//	tx - the coinbase transaction.
+//	height - the height of the block containing tx.
+//	params - the network parameters.
+//
+// Returns:
+//
+//	error - nil if valid, otherwise ErrInvalidCoinbase, ErrCoinbaseScriptSigSize
+//	        or ErrCoinbaseHeight describing the first failed check.
+func ValidateCoinbase(tx *bt.Tx, height int32, params *Params) error {
+	if tx == nil {
+		return fmt.Errorf("%w: nil transaction", ErrInvalidCoinbase)
+	}
+
+	if len(tx.Inputs) != 1 {
+		return fmt.Errorf("%w: %d inputs, expected 1", ErrInvalidCoinbase, len(tx.Inputs))
+	}
+
+	in := tx.Inputs[0]
+	prev := in.PreviousTxIDChainHash()
+	if in.PreviousTxOutIndex != math.MaxUint32 || prev == nil || *prev != (chainhash.Hash{}) {
+		return fmt.Errorf("%w: input does not spend the null outpoint", ErrInvalidCoinbase)
+	}
+
+	if len(tx.Outputs) == 0 {
+		return fmt.Errorf("%w: no outputs", ErrInvalidCoinbase)
+	}
+
+	var scriptSig []byte
+	if in.UnlockingScript != nil {
+		scriptSig = *in.UnlockingScript
+	}
+
+	if len(scriptSig) < MinCoinbaseScriptSigSize || len(scriptSig) > int(params.MaxCoinbaseScriptSigSize) {
+		return fmt.Errorf("%w: %d bytes, allowed %d to %d", ErrCoinbaseScriptSigSize,
+			len(scriptSig), MinCoinbaseScriptSigSize, params.MaxCoinbaseScriptSigSize)
+	}
+
+	if height >= params.BIP0034Height {
+		if expected := EncodeCoinbaseHeight(height); !bytes.HasPrefix(scriptSig, expected) {
+			return fmt.Errorf("%w: scriptSig must start with %x for height %d", ErrCoinbaseHeight, expected, height)
+		}
+	}
+
+	return nil
+}
+
+// IsCoinbaseMature reports whether an output of a coinbase mined at
+// createdHeight may be spent in a block at spendHeight, which requires at
+// least CoinbaseMaturity blocks between them.
+func (p *Params) IsCoinbaseMature(createdHeight, spendHeight int32) bool {
+	return int64(spendHeight)-int64(createdHeight) >= int64(p.CoinbaseMaturity)
+}
@@ -0,0 +1,191 @@
+package chaincfg
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/bscript"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// coinbaseTx builds a coinbase with the given hex scriptSig and one output.
+func coinbaseTx(t *testing.T, scriptSig string) *bt.Tx {
+	t.Helper()
+
+	script, err := bscript.NewFromHexString(scriptSig)
+	require.NoError(t, err)
+
+	in := &bt.Input{UnlockingScript: script, PreviousTxOutIndex: math.MaxUint32, SequenceNumber: math.MaxUint32}
+	require.NoError(t, in.PreviousTxIDAdd(&chainhash.Hash{}))
+
+	tx := bt.NewTx()
+	tx.Inputs = append(tx.Inputs, in)
+	tx.Outputs = append(tx.Outputs, &bt.Output{Satoshis: 5000000000, LockingScript: &bscript.Script{bscript.OpTRUE}})
+
+	return tx
+}
+
+// TestEncodeCoinbaseHeight checks the BIP0034 height serialization.
+func TestEncodeCoinbaseHeight(t *testing.T) {
+	tests := []struct {
+		height  int32
+		encoded string
+	}{
+		{0, "00"},
+		{1, "51"},
+		{16, "60"},
+		{17, "0111"},
+		{127, "017f"},
+		{128, "028000"},
+		{255, "02ff00"},
+		{256, "020001"},
+		{32768, "03008000"},
+		{227931, "035b7a03"}, // mainnet BIP0034 activation block
+		{500000, "0320a107"},
+		{620538, "03fa7709"}, // mainnet Genesis activation
+		{8388608, "0400008000"},
+		{math.MaxInt32, "04ffffff7f"},
+	}
+
+	for _, test := range tests {
+		encoded := EncodeCoinbaseHeight(test.height)
+		assert.Equal(t, test.encoded, hex.EncodeToString(encoded), test.height)
+
+		decoded, err := DecodeCoinbaseHeight(append(encoded, 0xab, 0xcd))
+		require.NoError(t, err, test.height)
+		assert.Equal(t, test.height, decoded)
+	}
+
+	assert.Nil(t, EncodeCoinbaseHeight(-1))
+}
+
+// TestDecodeCoinbaseHeightErrors checks malformed height pushes.
+func TestDecodeCoinbaseHeightErrors(t *testing.T) {
+	tests := map[string]string{
+		"empty":           "",
+		"OP_1NEGATE":      "4f",
+		"non-push opcode": "6a",
+		"push too long":   "060102030405060708",
+		"truncated":       "035b7a",
+		"negative":        "0181",
+		"non-minimal":     "020100",
+		"small as push":   "0105",
+		"PUSHDATA1":       "4c015b",
+	}
+
+	for name, scriptSig := range tests {
+		t.Run(name, func(t *testing.T) {
+			b, err := hex.DecodeString(scriptSig)
+			require.NoError(t, err)
+
+			_, err = DecodeCoinbaseHeight(b)
+			require.ErrorIs(t, err, ErrCoinbaseHeight)
+		})
+	}
+}
+
+// realCoinbases are complete mainnet coinbase transactions and the heights
+// of the blocks that contain them.
+var realCoinbases = []struct {
+	name   string
+	height int32
+	tx     string
+}{
+	{
+		"block 518847, before Genesis", 518847,
+		"01000000010000000000000000000000000000000000000000000000000000000000000000ffffffff4303bfea07322f53696d6f6e204f726469736820616e642053747561727420467265656d616e206d61646520746869732068617070656e2f9a46434790f7dbdea3430000ffffffff018a08ac4a000000001976a9148bf10d323ac757268eb715e613cb8e8e1d1793aa88ac00000000",
+	},
+	{
+		"block 861503, after Genesis", 861503,
+		"01000000010000000000000000000000000000000000000000000000000000000000000000ffffffff17033f250d2f43555656452f2c903fb60859897700d02700ffffffff01d864a012000000001976a914d648686cf603c11850f39600e37312738accca8f88ac00000000",
+	},
+}
+
+// TestValidateCoinbase checks coinbase validation against real mainnet
+// coinbases and around the BIP0034 activation.
+func TestValidateCoinbase(t *testing.T) {
+	// The mainnet genesis coinbase predates BIP0034.
+	genesisTx := MainNetParams.GenesisCoinbaseTx()
+	require.NoError(t, ValidateCoinbase(genesisTx, 0, &MainNetParams))
+
+	for _, test := range realCoinbases {
+		t.Run(test.name, func(t *testing.T) {
+			tx, err := bt.NewTxFromString(test.tx)
+			require.NoError(t, err)
+
+			require.NoError(t, ValidateCoinbase(tx, test.height, &MainNetParams))
+			require.ErrorIs(t, ValidateCoinbase(tx, test.height+1, &MainNetParams), ErrCoinbaseHeight)
+
+			height, err := DecodeCoinbaseHeight(*tx.Inputs[0].UnlockingScript)
+			require.NoError(t, err)
+			assert.Equal(t, test.height, height)
+		})
+	}
+
+	tests := []struct {
+		name      string
+		scriptSig string
+		height    int32
+		err       error
+	}{
+		{"block 1 scriptSig before BIP0034", "04ffff001d0104", 1, nil},
+		{"block 1 scriptSig at activation", "04ffff001d0104", 227931, ErrCoinbaseHeight},
+		{"block 1 scriptSig after activation", "04ffff001d0104", 227932, ErrCoinbaseHeight},
+		{"genesis scriptSig after activation", hex.EncodeToString(*genesisTx.Inputs[0].UnlockingScript), 227932, ErrCoinbaseHeight},
+		{"too short", "51", 1, ErrCoinbaseScriptSigSize},
+		{"empty", "", 1, ErrCoinbaseScriptSigSize},
+		{"too long", "0320a107" + hex.EncodeToString(make([]byte, 97)), 500000, ErrCoinbaseScriptSigSize},
+		{"longest allowed", "0320a107" + hex.EncodeToString(make([]byte, 96)), 500000, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateCoinbase(coinbaseTx(t, test.scriptSig), test.height, &MainNetParams)
+			if test.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+
+	// Regtest and stn never activate BIP0034.
+	require.NoError(t, ValidateCoinbase(coinbaseTx(t, "04ffff001d0104"), 500000, &RegressionNetParams))
+}
+
+// TestValidateCoinbaseShape checks the input and output shape rules.
+func TestValidateCoinbaseShape(t *testing.T) {
+	require.ErrorIs(t, ValidateCoinbase(nil, 0, &MainNetParams), ErrInvalidCoinbase)
+
+	tx := coinbaseTx(t, "04ffff001d0104")
+	tx.Inputs = append(tx.Inputs, tx.Inputs[0])
+	require.ErrorIs(t, ValidateCoinbase(tx, 1, &MainNetParams), ErrInvalidCoinbase)
+
+	tx = coinbaseTx(t, "04ffff001d0104")
+	tx.Inputs[0].PreviousTxOutIndex = 0
+	require.ErrorIs(t, ValidateCoinbase(tx, 1, &MainNetParams), ErrInvalidCoinbase)
+
+	tx = coinbaseTx(t, "04ffff001d0104")
+	require.NoError(t, tx.Inputs[0].PreviousTxIDAdd(&chainhash.Hash{1}))
+	require.ErrorIs(t, ValidateCoinbase(tx, 1, &MainNetParams), ErrInvalidCoinbase)
+
+	tx = coinbaseTx(t, "04ffff001d0104")
+	tx.Outputs = nil
+	require.ErrorIs(t, ValidateCoinbase(tx, 1, &MainNetParams), ErrInvalidCoinbase)
+}
+
+// TestIsCoinbaseMature checks the maturity boundary.
+func TestIsCoinbaseMature(t *testing.T) {
+	assert.False(t, MainNetParams.IsCoinbaseMature(91812, 91911))
+	assert.True(t, MainNetParams.IsCoinbaseMature(91812, 91912))
+	assert.False(t, MainNetParams.IsCoinbaseMature(91812, 91812))
+	assert.False(t, MainNetParams.IsCoinbaseMature(math.MaxInt32, math.MinInt32))
+
+	short := Params{CoinbaseMaturity: 1}
+	assert.True(t, short.IsCoinbaseMature(5, 6))
+	assert.False(t, short.IsCoinbaseMature(5, 5))
+}
@@ -0,0 +1,168 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// HardenedKeyStart is the index at which hardened BIP32 child keys begin.
+const HardenedKeyStart uint32 = 0x80000000
+
+// BIP44Purpose is the purpose level used by BIP44 derivation paths.
+const BIP44Purpose uint32 = 44
+
+var (
+	// ErrInvalidDerivationPath describes an error where a derivation path
+	// string or element cannot be parsed or is out of range.
+	ErrInvalidDerivationPath = errors.New("invalid derivation path")
+
+	// ErrCoinTypeMismatch describes an error where the BIP44 coin type of a
+	// derivation path does not match the HDCoinType of the network.
+	ErrCoinTypeMismatch = errors.New("derivation path coin type does not match network")
+)
+
+// DerivationPath is a BIP32 derivation path from the master key.  Hardened
+// elements have HardenedKeyStart added.
+type DerivationPath []uint32
+
+// ParseDerivationPath parses a derivation path such as "m/44'/145'/0'/0/5".
+//
+// The leading "m" is optional.  Hardened elements may be marked with "'",
+// "h" or "H".
+//
+// Parameters:
+//
+//	s - the derivation path string.
+//
+// Returns:
+//
+//	DerivationPath - the parsed path; "m" alone yields an empty path.
+//	error - ErrInvalidDerivationPath if an element is malformed or out of range.
+func ParseDerivationPath(s string) (DerivationPath, error) {
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if parts[0] == "m" || parts[0] == "M" {
+		parts = parts[1:]
+	}
+
+	path := make(DerivationPath, 0, len(parts))
+
+	for _, part := range parts {
+		hardened := false
+
+		if trimmed := strings.TrimRight(part, "'hH"); len(part)-len(trimmed) == 1 {
+			part, hardened = trimmed, true
+		}
+
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(n) >= HardenedKeyStart {
+			return nil, fmt.Errorf("%w: %q in %q", ErrInvalidDerivationPath, part, s)
+		}
+
+		index := uint32(n)
+		if hardened {
+			index += HardenedKeyStart
+		}
+
+		path = append(path, index)
+	}
+
+	return path, nil
+}
+
+// String formats the path with a leading "m" and "'" hardened markers.
+func (p DerivationPath) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("m")
+
+	for _, index := range p {
+		sb.WriteByte('/')
+
+		if index >= HardenedKeyStart {
+			sb.WriteString(strconv.FormatUint(uint64(index-HardenedKeyStart), 10))
+			sb.WriteByte('\'')
+		} else {
+			sb.WriteString(strconv.FormatUint(uint64(index), 10))
+		}
+	}
+
+	return sb.String()
+}
+
+// BIP44Path returns the BIP44 path m/44'/coin'/account'/change/index for the
+// network, using HDCoinType as the coin type.
+//
+// Parameters:
+//
+//	account - the account number, hardened in the returned path.
+//	change - 0 for external addresses, 1 for change addresses.
+//	index - the address index.
+//
+// Returns:
+//
+//	DerivationPath - the derivation path.
+//	error - ErrInvalidDerivationPath if any element is at or above
+//	        HardenedKeyStart or change is not 0 or 1.
+func (p *Params) BIP44Path(account, change, index uint32) (DerivationPath, error) {
+	for _, v := range []uint32{p.HDCoinType, account, change, index} {
+		if v >= HardenedKeyStart {
+			return nil, fmt.Errorf("%w: element %d out of range", ErrInvalidDerivationPath, v)
+		}
+	}
+
+	if change > 1 {
+		return nil, fmt.Errorf("%w: change %d, want 0 or 1", ErrInvalidDerivationPath, change)
+	}
+
+	return DerivationPath{
+		BIP44Purpose + HardenedKeyStart,
+		p.HDCoinType + HardenedKeyStart,
+		account + HardenedKeyStart,
+		change,
+		index,
+	}, nil
+}
+
+// ValidateBIP44Path checks that a path follows the BIP44 layout for the
+// network: a hardened 44' purpose, the network's hardened coin type and,
+// where present, a hardened account, an unhardened change of 0 or 1 and an
+// unhardened address index.  Paths may stop at any level below the coin
+// type, so account roots such as m/44'/145'/0' are accepted.
+//
+// Parameters:
+//
+//	path - the derivation path to check.
+//
+// Returns:
+//
+//	error - ErrCoinTypeMismatch if the coin type belongs to another network,
+//	        ErrInvalidDerivationPath for any other layout problem, or nil.
+func (p *Params) ValidateBIP44Path(path DerivationPath) error {
+	if len(path) < 2 || len(path) > 5 {
+		return fmt.Errorf("%w: %s has %d levels", ErrInvalidDerivationPath, path, len(path))
+	}
+
+	if path[0] != BIP44Purpose+HardenedKeyStart {
+		return fmt.Errorf("%w: %s is not a BIP44 path", ErrInvalidDerivationPath, path)
+	}
+
+	if path[1] != p.HDCoinType+HardenedKeyStart {
+		return fmt.Errorf("%w: %s, want coin type %d' for %s", ErrCoinTypeMismatch, path, p.HDCoinType, p.Name)
+	}
+
+	if len(path) > 2 && path[2] < HardenedKeyStart {
+		return fmt.Errorf("%w: %s has an unhardened account", ErrInvalidDerivationPath, path)
+	}
+
+	if len(path) > 3 && path[3] > 1 {
+		return fmt.Errorf("%w: %s has a change level other than 0 or 1", ErrInvalidDerivationPath, path)
+	}
+
+	if len(path) > 4 && path[4] >= HardenedKeyStart {
+		return fmt.Errorf("%w: %s has a hardened address index", ErrInvalidDerivationPath, path)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseDerivationPath checks parsing and formatting of derivation paths.
+func TestParseDerivationPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    DerivationPath
+		str     string
+		wantErr bool
+	}{
+		{"bip44 mainnet", "m/44'/145'/0'/0/5", DerivationPath{0x8000002c, 0x80000091, 0x80000000, 0, 5}, "m/44'/145'/0'/0/5", false},
+		{"h markers", "m/44h/1H/2'", DerivationPath{0x8000002c, 0x80000001, 0x80000002}, "m/44'/1'/2'", false},
+		{"no leading m", "0/1", DerivationPath{0, 1}, "m/0/1", false},
+		{"master only", "m", DerivationPath{}, "m", false},
+		{"empty element", "m//1", nil, "", true},
+		{"double marker", "m/44''", nil, "", true},
+		{"out of range", "m/2147483648", nil, "", true},
+		{"not a number", "m/x", nil, "", true},
+		{"empty", "", nil, "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseDerivationPath(tc.input)
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrInvalidDerivationPath)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.str, got.String())
+		})
+	}
+}
+
+// TestBIP44Path checks path construction and coin type validation per network.
+func TestBIP44Path(t *testing.T) {
+	path, err := MainNetParams.BIP44Path(0, 0, 5)
+	require.NoError(t, err)
+	assert.Equal(t, "m/44'/145'/0'/0/5", path.String())
+	require.NoError(t, MainNetParams.ValidateBIP44Path(path))
+	require.ErrorIs(t, TestNetParams.ValidateBIP44Path(path), ErrCoinTypeMismatch)
+
+	path, err = StnParams.BIP44Path(3, 1, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "m/44'/1'/3'/1/7", path.String())
+	require.NoError(t, StnParams.ValidateBIP44Path(path))
+	require.ErrorIs(t, MainNetParams.ValidateBIP44Path(path), ErrCoinTypeMismatch)
+
+	_, err = MainNetParams.BIP44Path(HardenedKeyStart, 0, 0)
+	require.ErrorIs(t, err, ErrInvalidDerivationPath)
+
+	_, err = MainNetParams.BIP44Path(0, 2, 0)
+	require.ErrorIs(t, err, ErrInvalidDerivationPath)
+
+	for _, s := range []string{"m/44'/145'/0'/1", "m/44'/145'/0'/0/2147483647"} {
+		p, err := ParseDerivationPath(s)
+		require.NoError(t, err)
+		require.NoError(t, MainNetParams.ValidateBIP44Path(p), s)
+	}
+
+	for _, s := range []string{
+		"m/44'", "m/49'/145'/0'", "m/44'/145'/0", "m/44'/145'/0'/0/0/0",
+		"m/44'/145'/0'/2", "m/44'/145'/0'/0'", "m/44'/145'/0'/5'/7'", "m/44'/145'/0'/0/7'",
+	} {
+		p, err := ParseDerivationPath(s)
+		require.NoError(t, err)
+		require.ErrorIs(t, MainNetParams.ValidateBIP44Path(p), ErrInvalidDerivationPath, s)
+	}
+}
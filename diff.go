@@ -0,0 +1,414 @@
+package chaincfg
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/bsv-blockchain/go-wire"
+)
+
+// ChangeKind classifies a FieldChange.
+type ChangeKind string
+
+// These are the kinds of change reported by Diff.
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// FieldChange describes a single difference between two Params values.
+//
+// Field is a path into Params such as "ChronicleActivationHeight",
+// "Deployments[CSV].StartTime", "Checkpoints[945000]" or
+// "GenesisBlock.Header.Nonce".  Keyed collections (checkpoints, DNS seeds,
+// fixed seeds) are compared by key, so appending a checkpoint is reported as
+// one addition rather than a shifted list.  Old and New hold the rendered
+// values; Old is empty for additions and New is empty for removals.
+type FieldChange struct {
+	Field string     `json:"field"`
+	Kind  ChangeKind `json:"kind"`
+	Old   string     `json:"old,omitempty"`
+	New   string     `json:"new,omitempty"`
+}
+
+// deploymentNames labels the Deployments array in field paths.
+var deploymentNames = [DefinedDeployments]string{
+	DeploymentTestDummy: "TestDummy",
+	DeploymentCSV:       "CSV",
+}
+
+// Types given dedicated comparisons by Diff.
+var (
+	bigIntType      = reflect.TypeFor[*big.Int]()
+	hashType        = reflect.TypeFor[chainhash.Hash]()
+	hashPtrType     = reflect.TypeFor[*chainhash.Hash]()
+	timeType        = reflect.TypeFor[time.Time]()
+	msgBlockPtrType = reflect.TypeFor[*wire.MsgBlock]()
+	checkpointsType = reflect.TypeFor[[]Checkpoint]()
+	activationType  = reflect.TypeFor[map[Upgrade]Checkpoint]()
+	dnsSeedsType    = reflect.TypeFor[[]DNSSeed]()
+	addrPortsType   = reflect.TypeFor[[]netip.AddrPort]()
+	deploymentsType = reflect.TypeFor[[DefinedDeployments]ConsensusDeployment]()
+)
+
+// Diff returns every difference between two Params values, in field order.
+//
+// All fields are compared, including ones added to Params later, because the
+// struct is walked by reflection.  Pointers are compared by value, the genesis
+// blocks header field by header field and transaction by transaction, and
+// keyed collections entry by entry.  A nil Params compares as the zero value.
+//
+// Parameters:
+//
+//	a - the old parameters.
+//	b - the new parameters.
+//
+// Returns:
+//
+//	[]FieldChange - the differences, or nil when the values are equivalent.
+func Diff(a, b *Params) []FieldChange {
+	if a == nil {
+		a = &Params{}
+	}
+
+	if b == nil {
+		b = &Params{}
+	}
+
+	var d differ
+
+	va, vb := reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem()
+	t := va.Type()
+
+	for i := range t.NumField() {
+		d.value(t.Field(i).Name, va.Field(i), vb.Field(i))
+	}
+
+	return d.changes
+}
+
+// WriteDiffText writes changes one per line in a form suited to release notes:
+//
+//	~ ChronicleActivationHeight: 943816 -> 950000
+//	+ Checkpoints[950000]: 0000...
+//	- DNSSeeds[seed.example]: filtering=true
+//
+// Parameters:
+//
+//	w - the destination.
+//	changes - the changes returned by Diff.
+//
+// Returns:
+//
+//	error - any write error.
+func WriteDiffText(w io.Writer, changes []FieldChange) error {
+	for _, c := range changes {
+		var err error
+
+		switch c.Kind {
+		case ChangeAdded:
+			_, err = fmt.Fprintf(w, "+ %s: %s\n", c.Field, c.New)
+		case ChangeRemoved:
+			_, err = fmt.Fprintf(w, "- %s: %s\n", c.Field, c.Old)
+		case ChangeModified:
+			_, err = fmt.Fprintf(w, "~ %s: %s -> %s\n", c.Field, c.Old, c.New)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteDiffJSON writes changes as an indented JSON array, suited to CI gates.
+// An empty diff is written as [] rather than null.
+//
+// Parameters:
+//
+//	w - the destination.
+//	changes - the changes returned by Diff.
+//
+// Returns:
+//
+//	error - any encoding or write error.
+func WriteDiffJSON(w io.Writer, changes []FieldChange) error {
+	if changes == nil {
+		changes = []FieldChange{}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(changes)
+}
+
+// differ accumulates the changes found while walking two Params values.
+type differ struct {
+	changes []FieldChange
+}
+
+// add records a change; empty old or new values mark additions and removals.
+func (d *differ) add(field, oldValue, newValue string) {
+	kind := ChangeModified
+
+	switch {
+	case oldValue == newValue:
+		return
+	case oldValue == "":
+		kind = ChangeAdded
+	case newValue == "":
+		kind = ChangeRemoved
+	}
+
+	d.changes = append(d.changes, FieldChange{Field: field, Kind: kind, Old: oldValue, New: newValue})
+}
+
+// modified records a change between two rendered values, even when one of
+// them is empty.
+func (d *differ) modified(field, oldValue, newValue string) {
+	if oldValue != newValue {
+		d.changes = append(d.changes, FieldChange{Field: field, Kind: ChangeModified, Old: oldValue, New: newValue})
+	}
+}
+
+// value compares two values of the same type found at path.
+func (d *differ) value(path string, x, y reflect.Value) {
+	switch x.Type() {
+	case bigIntType:
+		d.modified(path, renderBigInt(x.Interface().(*big.Int)), renderBigInt(y.Interface().(*big.Int)))
+	case hashType:
+		xh, yh := x.Interface().(chainhash.Hash), y.Interface().(chainhash.Hash)
+		d.modified(path, xh.String(), yh.String())
+	case hashPtrType:
+		d.modified(path, renderHash(x.Interface().(*chainhash.Hash)), renderHash(y.Interface().(*chainhash.Hash)))
+	case timeType:
+		d.modified(path, x.Interface().(time.Time).UTC().Format(time.RFC3339), y.Interface().(time.Time).UTC().Format(time.RFC3339))
+	case msgBlockPtrType:
+		d.block(path, x.Interface().(*wire.MsgBlock), y.Interface().(*wire.MsgBlock))
+	case checkpointsType:
+		d.checkpoints(path, x.Interface().([]Checkpoint), y.Interface().([]Checkpoint))
+	case activationType:
+		d.activationBlocks(path, x.Interface().(map[Upgrade]Checkpoint), y.Interface().(map[Upgrade]Checkpoint))
+	case dnsSeedsType:
+		d.dnsSeeds(path, x.Interface().([]DNSSeed), y.Interface().([]DNSSeed))
+	case addrPortsType:
+		d.addrPorts(path, x.Interface().([]netip.AddrPort), y.Interface().([]netip.AddrPort))
+	case deploymentsType:
+		for i := range x.Len() {
+			d.value(path+"["+deploymentNames[i]+"]", x.Index(i), y.Index(i))
+		}
+	default:
+		d.generic(path, x, y)
+	}
+}
+
+// generic compares values without a dedicated comparison by their kind.
+func (d *differ) generic(path string, x, y reflect.Value) {
+	switch x.Kind() { //nolint:exhaustive // remaining kinds are rendered as scalars
+	case reflect.Struct:
+		for i := range x.NumField() {
+			if f := x.Type().Field(i); f.IsExported() {
+				d.value(path+"."+f.Name, x.Field(i), y.Field(i))
+			}
+		}
+	case reflect.Array:
+		if x.Type().Elem().Kind() == reflect.Uint8 {
+			d.modified(path, renderByteArray(x), renderByteArray(y))
+			return
+		}
+
+		for i := range x.Len() {
+			d.value(path+"["+strconv.Itoa(i)+"]", x.Index(i), y.Index(i))
+		}
+	case reflect.Slice:
+		d.modified(path, renderSlice(x), renderSlice(y))
+	default:
+		d.modified(path, fmt.Sprint(x.Interface()), fmt.Sprint(y.Interface()))
+	}
+}
+
+// block compares two genesis blocks header field by header field and then
+// transaction by transaction.
+func (d *differ) block(path string, x, y *wire.MsgBlock) {
+	if x == nil || y == nil {
+		d.modified(path, renderBlock(x), renderBlock(y))
+		return
+	}
+
+	d.value(path+".Header", reflect.ValueOf(x.Header), reflect.ValueOf(y.Header))
+
+	for i := range max(len(x.Transactions), len(y.Transactions)) {
+		var oldTx, newTx string
+
+		if i < len(x.Transactions) {
+			oldTx = x.Transactions[i].TxHash().String()
+		}
+
+		if i < len(y.Transactions) {
+			newTx = y.Transactions[i].TxHash().String()
+		}
+
+		d.add(fmt.Sprintf("%s.Transactions[%d]", path, i), oldTx, newTx)
+	}
+}
+
+// checkpoints compares checkpoints keyed by height.
+func (d *differ) checkpoints(path string, x, y []Checkpoint) {
+	oldHashes := make(map[int32]string, len(x))
+	newHashes := make(map[int32]string, len(y))
+	heights := make([]int32, 0, len(x)+len(y))
+
+	for _, c := range x {
+		oldHashes[c.Height] = renderHash(c.Hash)
+		heights = append(heights, c.Height)
+	}
+
+	for _, c := range y {
+		newHashes[c.Height] = renderHash(c.Hash)
+		heights = append(heights, c.Height)
+	}
+
+	slices.Sort(heights)
+
+	for _, h := range slices.Compact(heights) {
+		d.add(fmt.Sprintf("%s[%d]", path, h), oldHashes[h], newHashes[h])
+	}
+}
+
+// activationBlocks compares activation blocks keyed by upgrade.
+func (d *differ) activationBlocks(path string, x, y map[Upgrade]Checkpoint) {
+	render := func(m map[Upgrade]Checkpoint, u Upgrade) string {
+		cp, ok := m[u]
+		if !ok {
+			return ""
+		}
+
+		return fmt.Sprintf("%d:%s", cp.Height, renderHash(cp.Hash))
+	}
+
+	upgrades := make([]Upgrade, 0, len(x)+len(y))
+	for u := range x {
+		upgrades = append(upgrades, u)
+	}
+
+	for u := range y {
+		upgrades = append(upgrades, u)
+	}
+
+	slices.Sort(upgrades)
+
+	for _, u := range slices.Compact(upgrades) {
+		d.add(path+"["+string(u)+"]", render(x, u), render(y, u))
+	}
+}
+
+// dnsSeeds compares DNS seeds keyed by host.
+func (d *differ) dnsSeeds(path string, x, y []DNSSeed) {
+	render := func(s DNSSeed) string { return "filtering=" + strconv.FormatBool(s.HasFiltering) }
+
+	oldSeeds := make(map[string]string, len(x))
+	newSeeds := make(map[string]string, len(y))
+	hosts := make([]string, 0, len(x)+len(y))
+
+	for _, s := range x {
+		oldSeeds[s.Host] = render(s)
+		hosts = append(hosts, s.Host)
+	}
+
+	for _, s := range y {
+		newSeeds[s.Host] = render(s)
+		hosts = append(hosts, s.Host)
+	}
+
+	seen := make(map[string]struct{}, len(hosts))
+
+	for _, h := range hosts {
+		if _, ok := seen[h]; ok {
+			continue
+		}
+
+		seen[h] = struct{}{}
+
+		d.add(path+"["+h+"]", oldSeeds[h], newSeeds[h])
+	}
+}
+
+// addrPorts compares address lists as sets.
+func (d *differ) addrPorts(path string, x, y []netip.AddrPort) {
+	all := slices.Concat(x, y)
+	slices.SortFunc(all, func(a, b netip.AddrPort) int { return a.Compare(b) })
+
+	for _, a := range slices.Compact(all) {
+		var oldValue, newValue string
+
+		if slices.Contains(x, a) {
+			oldValue = a.String()
+		}
+
+		if slices.Contains(y, a) {
+			newValue = a.String()
+		}
+
+		d.add(path+"["+a.String()+"]", oldValue, newValue)
+	}
+}
+
+// renderBigInt renders a big integer in hex, or "<nil>".
+func renderBigInt(v *big.Int) string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	return "0x" + v.Text(16)
+}
+
+// renderHash renders a hash in its usual byte-reversed hex form, or "<nil>".
+func renderHash(h *chainhash.Hash) string {
+	if h == nil {
+		return "<nil>"
+	}
+
+	return h.String()
+}
+
+// renderBlock renders a block by its hash, or "<nil>".
+func renderBlock(b *wire.MsgBlock) string {
+	if b == nil {
+		return "<nil>"
+	}
+
+	return b.BlockHash().String()
+}
+
+// renderByteArray renders a fixed-size byte array in hex.
+func renderByteArray(v reflect.Value) string {
+	b := make([]byte, v.Len())
+	for i := range b {
+		b[i] = byte(v.Index(i).Uint())
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// renderSlice renders a slice as a bracketed, comma-separated list.
+func renderSlice(v reflect.Value) string {
+	items := make([]string, v.Len())
+	for i := range items {
+		items[i] = fmt.Sprint(v.Index(i).Interface())
+	}
+
+	return "[" + strings.Join(items, ", ") + "]"
+}
@@ -0,0 +1,112 @@
+package chaincfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiffIdentical ensures a network compared to itself, or to a copy, has no
+// changes.
+func TestDiffIdentical(t *testing.T) {
+	for _, p := range builtinNets {
+		assert.Empty(t, Diff(p, p), p.Name)
+
+		clone := *p
+		assert.Empty(t, Diff(p, &clone), p.Name)
+	}
+}
+
+// TestDiff checks the field paths and kinds of a derived network.
+func TestDiff(t *testing.T) {
+	derived := MainNetParams
+	derived.ChronicleActivationHeight++
+	derived.Checkpoints = append(derived.Checkpoints[:len(derived.Checkpoints):len(derived.Checkpoints)],
+		Checkpoint{950000, newHashFromStr("00000000000000000000000000000000000000000000000000000000000000aa")})
+	derived.DNSSeeds = []DNSSeed{{"seed.bitcoinsv.io", false}, {"seed.example", true}}
+	derived.FixedSeeds = []netip.AddrPort{netip.MustParseAddrPort("192.0.2.1:8333")}
+	derived.Deployments[DeploymentCSV].StartTime = 1
+	derived.HDPublicKeyID = [4]byte{1, 2, 3, 4}
+	derived.Aliases = []string{"main", "bsv"}
+
+	block := *MainNetParams.GenesisBlock
+	block.Header.Nonce++
+	block.Header.Timestamp = time.Unix(1231006506, 0)
+	derived.GenesisBlock = &block
+
+	changes := Diff(&MainNetParams, &derived)
+
+	byField := make(map[string]FieldChange, len(changes))
+	for _, c := range changes {
+		byField[c.Field] = c
+	}
+
+	assert.Equal(t, FieldChange{"ChronicleActivationHeight", ChangeModified, "943816", "943817"}, byField["ChronicleActivationHeight"])
+	assert.Equal(t, ChangeAdded, byField["Checkpoints[950000]"].Kind)
+	assert.Equal(t, FieldChange{"DNSSeeds[seed.bitcoinsv.io]", ChangeModified, "filtering=true", "filtering=false"}, byField["DNSSeeds[seed.bitcoinsv.io]"])
+	assert.Equal(t, ChangeAdded, byField["DNSSeeds[seed.example]"].Kind)
+	assert.Equal(t, ChangeAdded, byField["FixedSeeds[192.0.2.1:8333]"].Kind)
+	assert.Equal(t, FieldChange{"Deployments[CSV].StartTime", ChangeModified, "1462060800", "1"}, byField["Deployments[CSV].StartTime"])
+	assert.Equal(t, FieldChange{"HDPublicKeyID", ChangeModified, "0488b21e", "01020304"}, byField["HDPublicKeyID"])
+	assert.Equal(t, FieldChange{"Aliases", ChangeModified, "[main]", "[main, bsv]"}, byField["Aliases"])
+	assert.Equal(t, FieldChange{"GenesisBlock.Header.Nonce", ChangeModified, "2083236893", "2083236894"}, byField["GenesisBlock.Header.Nonce"])
+	assert.Equal(t, FieldChange{"GenesisBlock.Header.Timestamp", ChangeModified, "2009-01-03T18:15:05Z", "2009-01-03T18:15:06Z"}, byField["GenesisBlock.Header.Timestamp"])
+	assert.Len(t, changes, 10)
+
+	// The removal side of a keyed collection.
+	changes = Diff(&derived, &MainNetParams)
+	assert.Contains(t, changes, FieldChange{Field: "Checkpoints[950000]", Kind: ChangeRemoved, Old: "00000000000000000000000000000000000000000000000000000000000000aa"})
+}
+
+// TestDiffNetworks checks that comparing distinct networks reports core fields.
+func TestDiffNetworks(t *testing.T) {
+	changes := Diff(&MainNetParams, &TestNetParams)
+
+	fields := make([]string, 0, len(changes))
+	for _, c := range changes {
+		fields = append(fields, c.Field)
+	}
+
+	assert.Contains(t, fields, "Name")
+	assert.Contains(t, fields, "Net")
+	assert.Contains(t, fields, "GenesisHash")
+	assert.Contains(t, fields, "GenesisBlock.Header.Nonce")
+	assert.NotContains(t, fields, "PowLimit")
+
+	assert.NotEmpty(t, Diff(nil, &StnParams))
+	assert.Empty(t, Diff(nil, nil))
+	assert.Equal(t, []FieldChange{{"Net", ChangeModified, wire.BitcoinNet(0).String(), wire.MainNet.String()}},
+		Diff(&Params{}, &Params{Net: wire.MainNet}))
+}
+
+// TestWriteDiff checks the text and JSON renderers.
+func TestWriteDiff(t *testing.T) {
+	changes := []FieldChange{
+		{"CoinbaseMaturity", ChangeModified, "100", "10"},
+		{"Checkpoints[1]", ChangeAdded, "", "00aa"},
+		{"DNSSeeds[seed.example]", ChangeRemoved, "filtering=true", ""},
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteDiffText(&buf, changes))
+	assert.Equal(t, "~ CoinbaseMaturity: 100 -> 10\n+ Checkpoints[1]: 00aa\n- DNSSeeds[seed.example]: filtering=true\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteDiffJSON(&buf, changes))
+
+	var decoded []FieldChange
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, changes, decoded)
+
+	buf.Reset()
+	require.NoError(t, WriteDiffJSON(&buf, nil))
+	assert.JSONEq(t, "[]", buf.String())
+}
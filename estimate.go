@@ -0,0 +1,170 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// estimateZ is the standard normal quantile giving the roughly 95%
+// confidence bounds returned by the estimators.
+const estimateZ = 1.96
+
+var (
+	// ErrNoBlockRate describes an error where neither an observed block time
+	// nor a positive TargetTimePerBlock is available for an estimate.
+	ErrNoBlockRate = errors.New("no block rate to estimate from")
+
+	// ErrEstimateOutOfRange describes an error where an estimated time lies
+	// further from the anchor than a time.Duration can represent, about 292
+	// years.
+	ErrEstimateOutOfRange = errors.New("estimate out of range")
+)
+
+// ChainTip anchors height and time estimates to a known block, usually the
+// current best block.  Checkpoints carry no timestamp, so the caller supplies
+// the anchor's header time.
+type ChainTip struct {
+	// Height is the height of the anchor block.
+	Height int32
+
+	// Time is the timestamp of the anchor block.
+	Time time.Time
+
+	// AverageBlockTime is the observed mean time between recent blocks.
+	// When zero, the network's TargetTimePerBlock is used.
+	AverageBlockTime time.Duration
+}
+
+// TimeEstimate is an estimated block time with roughly 95% confidence
+// bounds.
+type TimeEstimate struct {
+	Time     time.Time
+	Earliest time.Time
+	Latest   time.Time
+}
+
+// HeightEstimate is an estimated block height with roughly 95% confidence
+// bounds.
+type HeightEstimate struct {
+	Height  int32
+	Lowest  int32
+	Highest int32
+}
+
+// EstimateTimeAtHeight estimates when the block at height h is or was
+// mined, for example to show a countdown to ChronicleActivationHeight.
+//
+// Blocks are modeled as a Poisson process at the tip's AverageBlockTime, or
+// TargetTimePerBlock when that is zero.  The time to mine n blocks then has a
+// standard deviation of sqrt(n) block times, which gives the bounds.  Heights
+// below the tip are estimated backwards in the same way.
+//
+// Parameters:
+//
+//	h - the height to estimate.
+//	tip - the anchor block.
+//
+// Returns:
+//
+//	TimeEstimate - the expected time and its bounds.
+//	error - ErrNoBlockRate if no block time is available, or
+//	        ErrEstimateOutOfRange if a bound is too far from the tip.
+func (p *Params) EstimateTimeAtHeight(h int32, tip ChainTip) (TimeEstimate, error) {
+	blockTime, err := p.estimateBlockTime(tip)
+	if err != nil {
+		return TimeEstimate{}, err
+	}
+
+	n := float64(h) - float64(tip.Height)
+	offset := n * float64(blockTime)
+	marginNs := estimateZ * math.Sqrt(math.Abs(n)) * float64(blockTime)
+
+	// float64(math.MaxInt64) rounds up to 2^63, which no Duration reaches.
+	if math.Abs(offset)+marginNs >= float64(math.MaxInt64) {
+		return TimeEstimate{}, fmt.Errorf("%w: height %d is %.0f blocks from the tip at %d",
+			ErrEstimateOutOfRange, h, n, tip.Height)
+	}
+
+	expected := tip.Time.Add(time.Duration(offset))
+	margin := time.Duration(marginNs)
+
+	est := TimeEstimate{Time: expected, Earliest: expected.Add(-margin), Latest: expected.Add(margin)}
+
+	// A future block cannot be mined before the tip, nor a past one after it.
+	if n > 0 && est.Earliest.Before(tip.Time) {
+		est.Earliest = tip.Time
+	}
+
+	if n < 0 && est.Latest.After(tip.Time) {
+		est.Latest = tip.Time
+	}
+
+	return est, nil
+}
+
+// EstimateHeightAtTime estimates the height of the chain at time t.
+//
+// The number of blocks mined between the tip and t is modeled as Poisson
+// with a mean of the elapsed time over the block time, so the bounds widen
+// with the square root of that mean.  Times before the tip are estimated
+// backwards in the same way.
+//
+// Parameters:
+//
+//	t - the time to estimate.
+//	tip - the anchor block.
+//
+// Returns:
+//
+//	HeightEstimate - the expected height and its bounds.
+//	error - ErrNoBlockRate if no block time is available.
+func (p *Params) EstimateHeightAtTime(t time.Time, tip ChainTip) (HeightEstimate, error) {
+	blockTime, err := p.estimateBlockTime(tip)
+	if err != nil {
+		return HeightEstimate{}, err
+	}
+
+	// t.Sub saturates beyond about 292 years, so work in seconds.
+	elapsed := float64(t.Unix()-tip.Time.Unix())*float64(time.Second) +
+		float64(t.Nanosecond()-tip.Time.Nanosecond())
+	n := elapsed / float64(blockTime)
+	margin := estimateZ * math.Sqrt(math.Abs(n))
+	base := float64(tip.Height)
+
+	est := HeightEstimate{
+		Height:  clampHeight(base + math.Round(n)),
+		Lowest:  clampHeight(base + math.Floor(n-margin)),
+		Highest: clampHeight(base + math.Ceil(n+margin)),
+	}
+
+	// Blocks are never unmined going forward nor mined going backwards.
+	if n > 0 {
+		est.Lowest = max(est.Lowest, tip.Height)
+	}
+
+	if n < 0 {
+		est.Highest = min(est.Highest, tip.Height)
+	}
+
+	return est, nil
+}
+
+// estimateBlockTime returns the block time to estimate with.
+func (p *Params) estimateBlockTime(tip ChainTip) (time.Duration, error) {
+	if tip.AverageBlockTime > 0 {
+		return tip.AverageBlockTime, nil
+	}
+
+	if p.TargetTimePerBlock > 0 {
+		return p.TargetTimePerBlock, nil
+	}
+
+	return 0, ErrNoBlockRate
+}
+
+// clampHeight converts a height to int32, limited to 0 and math.MaxInt32.
+func clampHeight(h float64) int32 {
+	return int32(min(max(h, 0), math.MaxInt32))
+}
@@ -0,0 +1,114 @@
+package chaincfg
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEstimateTimeAtHeight checks time estimates and their bounds.
+func TestEstimateTimeAtHeight(t *testing.T) {
+	tip := ChainTip{Height: 900000, Time: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)}
+
+	est, err := MainNetParams.EstimateTimeAtHeight(900100, tip)
+	require.NoError(t, err)
+	assert.Equal(t, tip.Time.Add(1000*time.Minute), est.Time)
+	assert.Equal(t, tip.Time.Add(804*time.Minute), est.Earliest)
+	assert.Equal(t, tip.Time.Add(1196*time.Minute), est.Latest)
+
+	est, err = MainNetParams.EstimateTimeAtHeight(tip.Height, tip)
+	require.NoError(t, err)
+	assert.Equal(t, TimeEstimate{tip.Time, tip.Time, tip.Time}, est)
+
+	// One block ahead: the lower bound is clamped to the tip.
+	est, err = MainNetParams.EstimateTimeAtHeight(tip.Height+1, tip)
+	require.NoError(t, err)
+	assert.Equal(t, tip.Time, est.Earliest)
+
+	// Backwards: the upper bound is clamped to the tip.
+	est, err = MainNetParams.EstimateTimeAtHeight(tip.Height-1, tip)
+	require.NoError(t, err)
+	assert.Equal(t, tip.Time.Add(-10*time.Minute), est.Time)
+	assert.Equal(t, tip.Time, est.Latest)
+
+	// An observed block rate replaces TargetTimePerBlock.
+	tip.AverageBlockTime = 5 * time.Minute
+	est, err = MainNetParams.EstimateTimeAtHeight(900100, tip)
+	require.NoError(t, err)
+	assert.Equal(t, tip.Time.Add(500*time.Minute), est.Time)
+
+	_, err = (&Params{}).EstimateTimeAtHeight(1, ChainTip{})
+	require.ErrorIs(t, err, ErrNoBlockRate)
+}
+
+// TestEstimateTimeAtHeightRange checks that estimates beyond the range of a
+// time.Duration are refused rather than wrapped around.
+func TestEstimateTimeAtHeightRange(t *testing.T) {
+	tip := ChainTip{Height: 900000, Time: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)}
+
+	_, err := MainNetParams.EstimateTimeAtHeight(20_000_000, tip)
+	require.ErrorIs(t, err, ErrEstimateOutOfRange)
+
+	_, err = MainNetParams.EstimateTimeAtHeight(0, ChainTip{Height: 20_000_000, Time: tip.Time})
+	require.ErrorIs(t, err, ErrEstimateOutOfRange)
+
+	// About 285 years ahead is still in range and correctly ordered.
+	est, err := MainNetParams.EstimateTimeAtHeight(15_900_000, tip)
+	require.NoError(t, err)
+	assert.True(t, est.Time.After(tip.Time))
+	assert.False(t, est.Earliest.After(est.Time))
+	assert.False(t, est.Latest.Before(est.Time))
+}
+
+// TestEstimateHeightAtTime checks height estimates and their bounds.
+func TestEstimateHeightAtTime(t *testing.T) {
+	tip := ChainTip{Height: 900000, Time: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)}
+
+	est, err := MainNetParams.EstimateHeightAtTime(tip.Time.Add(1000*time.Minute), tip)
+	require.NoError(t, err)
+	assert.Equal(t, HeightEstimate{Height: 900100, Lowest: 900080, Highest: 900120}, est)
+
+	est, err = MainNetParams.EstimateHeightAtTime(tip.Time.Add(time.Minute), tip)
+	require.NoError(t, err)
+	assert.Equal(t, tip.Height, est.Height)
+	assert.Equal(t, tip.Height, est.Lowest)
+
+	est, err = MainNetParams.EstimateHeightAtTime(tip.Time.Add(-1000*time.Minute), tip)
+	require.NoError(t, err)
+	assert.Equal(t, HeightEstimate{Height: 899900, Lowest: 899880, Highest: 899920}, est)
+
+	// Estimates never go below genesis or above the int32 range.
+	est, err = MainNetParams.EstimateHeightAtTime(time.Unix(0, 0), ChainTip{Height: 10, Time: tip.Time})
+	require.NoError(t, err)
+	assert.Equal(t, HeightEstimate{}, est)
+
+	est, err = MainNetParams.EstimateHeightAtTime(tip.Time.Add(math.MaxInt64), ChainTip{Height: math.MaxInt32 - 5, Time: tip.Time})
+	require.NoError(t, err)
+	assert.Equal(t, int32(math.MaxInt32), est.Highest)
+
+	// Times beyond the range of a time.Duration are not saturated.
+	future := tip.Time.AddDate(1000, 0, 0)
+	est, err = MainNetParams.EstimateHeightAtTime(future, tip)
+	require.NoError(t, err)
+	assert.Equal(t, tip.Height+int32((future.Unix()-tip.Time.Unix())/600), est.Height)
+
+	_, err = (&Params{}).EstimateHeightAtTime(tip.Time, ChainTip{})
+	require.ErrorIs(t, err, ErrNoBlockRate)
+}
+
+// TestEstimateRoundTrip ensures the two estimators agree.
+func TestEstimateRoundTrip(t *testing.T) {
+	tip := ChainTip{Height: 100, Time: TestNetParams.GenesisBlock.Header.Timestamp.Add(1000 * time.Minute)}
+
+	for _, h := range []int32{0, 50, 100, 101, 5000} {
+		ts, err := TestNetParams.EstimateTimeAtHeight(h, tip)
+		require.NoError(t, err)
+
+		hs, err := TestNetParams.EstimateHeightAtTime(ts.Time, tip)
+		require.NoError(t, err)
+		assert.Equal(t, h, hs.Height)
+	}
+}
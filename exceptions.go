@@ -0,0 +1,58 @@
+package chaincfg
+
+import (
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/bsv-blockchain/go-wire"
+)
+
+// IsUnspendableGenesisOutput reports whether the outpoint spends an output of
+// the genesis coinbase transaction.
+//
+// The genesis coinbase is never added to the UTXO set, so its outputs can
+// never be spent.  UTXO stores should skip it when connecting the genesis
+// block and validation should reject any spend of it.
+//
+// Parameters:
+//
+//	outpoint - the outpoint to check.
+//
+// Returns:
+//
+//	bool - true if the outpoint refers to the genesis coinbase.
+func (p *Params) IsUnspendableGenesisOutput(outpoint wire.OutPoint) bool {
+	coinbase := p.genesisCoinbase()
+	if coinbase == nil {
+		return false
+	}
+
+	return outpoint.Hash == coinbase.TxHash()
+}
+
+// IsBIP30Exempt reports whether the block at the given height and hash is
+// one of the network's BIP30Exceptions, whose coinbase may duplicate an
+// earlier unspent coinbase.
+//
+// Both the height and the hash must match, so a competing block at an
+// exception height is still checked.
+//
+// Parameters:
+//
+//	height - the block height.
+//	hash - the block hash.
+//
+// Returns:
+//
+//	bool - true if the block is exempt from the BIP0030 check.
+func (p *Params) IsBIP30Exempt(height int32, hash *chainhash.Hash) bool {
+	if hash == nil {
+		return false
+	}
+
+	for _, e := range p.BIP30Exceptions {
+		if e.Height == height && e.Hash != nil && e.Hash.IsEqual(hash) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,50 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIsUnspendableGenesisOutput checks the genesis coinbase outpoint of
+// every built-in network.
+func TestIsUnspendableGenesisOutput(t *testing.T) {
+	for _, p := range builtinNets {
+		txid := p.GenesisBlock.Transactions[0].TxHash()
+
+		assert.True(t, p.IsUnspendableGenesisOutput(wire.OutPoint{Hash: txid, Index: 0}), p.Name)
+		assert.False(t, p.IsUnspendableGenesisOutput(wire.OutPoint{Hash: chainhash.Hash{1}, Index: 0}), p.Name)
+		assert.False(t, p.IsUnspendableGenesisOutput(wire.OutPoint{Index: 0xffffffff}), p.Name)
+	}
+
+	// tstn has its own genesis coinbase, so the output of the coinbase the
+	// other networks share is not reported as its unspendable genesis output.
+	// A Params without a genesis block reports no output at all.
+	shared := wire.OutPoint{Hash: MainNetParams.GenesisBlock.Transactions[0].TxHash()}
+	assert.False(t, TeraScalingTestNetParams.IsUnspendableGenesisOutput(shared))
+
+	assert.False(t, (&Params{}).IsUnspendableGenesisOutput(shared))
+}
+
+// TestIsBIP30Exempt checks the mainnet duplicate coinbase exceptions.
+func TestIsBIP30Exempt(t *testing.T) {
+	h91842 := newHashFromStr("00000000000a4d0a398161ffc163c503763b1f4360639393e0e4c8e300e0caec")
+	h91880 := newHashFromStr("00000000000743f190a18c5577a3c2d2a1f610ae9601ac046a38084ccb7cd721")
+
+	assert.True(t, MainNetParams.IsBIP30Exempt(91842, h91842))
+	assert.True(t, MainNetParams.IsBIP30Exempt(91880, h91880))
+
+	assert.False(t, MainNetParams.IsBIP30Exempt(91842, h91880), "hash at wrong height")
+	assert.False(t, MainNetParams.IsBIP30Exempt(91843, h91842), "wrong height")
+	assert.False(t, MainNetParams.IsBIP30Exempt(91842, &chainhash.Hash{}), "competing block")
+	assert.False(t, MainNetParams.IsBIP30Exempt(91842, nil))
+
+	for _, p := range builtinNets {
+		if p != &MainNetParams {
+			assert.Empty(t, p.BIP30Exceptions, p.Name)
+			assert.False(t, p.IsBIP30Exempt(91842, h91842), p.Name)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidExtendedKey describes an error where a serialized BIP32
+	// extended key cannot be decoded or has an unexpected length or key
+	// prefix.
+	ErrInvalidExtendedKey = errors.New("invalid extended key")
+
+	// ErrExtendedKeyChecksum describes an error where the checksum of a
+	// serialized extended key does not match its payload.
+	ErrExtendedKeyChecksum = errors.New("invalid extended key checksum")
+)
+
+const (
+	// extendedKeyLen is the length of a serialized BIP32 extended key without
+	// its checksum: version(4) || depth(1) || parent fingerprint(4) ||
+	// child number(4) || chain code(32) || key data(33).
+	extendedKeyLen = 78
+
+	// extendedKeyDataOffset is the offset of the 33-byte key data.
+	extendedKeyDataOffset = 45
+)
+
+// ParamsForExtendedKey returns the networks whose HD version bytes match a
+// serialized extended key (xprv, xpub, tprv, tpub, ...).
+//
+// Mainnet keys resolve to a single network; test keys resolve to every
+// network sharing the tprv/tpub magics, in lookup order.
+//
+// Parameters:
+//
+//	serialized - the base58check encoded extended key.
+//
+// Returns:
+//
+//	[]*Params - the candidate networks.
+//	error - ErrInvalidExtendedKey, ErrExtendedKeyChecksum or ErrUnknownHDKeyID on failure.
+func ParamsForExtendedKey(serialized string) ([]*Params, error) {
+	payload, err := decodeExtendedKey(serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	nets, _ := paramsForHDKeyID([4]byte(payload[:4]))
+	if len(nets) == 0 {
+		return nil, fmt.Errorf("%w: %x", ErrUnknownHDKeyID, payload[:4])
+	}
+
+	return nets, nil
+}
+
+// ConvertExtendedKey re-encodes a serialized extended key with the version
+// bytes of another network.  The key material is unchanged; only the version
+// prefix and checksum are rewritten, so a watch-only xpub can be moved to a
+// test network as a tpub and back.
+//
+// Parameters:
+//
+//	key - the base58check encoded extended key.
+//	toParams - the network whose HDPrivateKeyID or HDPublicKeyID is applied.
+//
+// Returns:
+//
+//	string - the re-encoded extended key.
+//	error - ErrInvalidExtendedKey, ErrExtendedKeyChecksum or ErrUnknownHDKeyID on failure.
+func ConvertExtendedKey(key string, toParams *Params) (string, error) {
+	if toParams == nil {
+		return "", ErrUnknownNetwork
+	}
+
+	payload, err := decodeExtendedKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	nets, private := paramsForHDKeyID([4]byte(payload[:4]))
+	if len(nets) == 0 {
+		return "", fmt.Errorf("%w: %x", ErrUnknownHDKeyID, payload[:4])
+	}
+
+	// A private key carries a 0x00 pad before the 32-byte scalar, a public
+	// key a compressed point.  A mismatch means the version bytes lie about
+	// the key type and converting would produce an unusable key.
+	if isPrivData := payload[extendedKeyDataOffset] == 0x00; isPrivData != private {
+		return "", fmt.Errorf("%w: key data does not match version %x", ErrInvalidExtendedKey, payload[:4])
+	}
+
+	converted := make([]byte, extendedKeyLen)
+	copy(converted, payload)
+
+	if private {
+		copy(converted[:4], toParams.HDPrivateKeyID[:])
+	} else {
+		copy(converted[:4], toParams.HDPublicKeyID[:])
+	}
+
+	return base58CheckEncode(converted), nil
+}
+
+// decodeExtendedKey decodes a base58check extended key and checks its length.
+func decodeExtendedKey(serialized string) ([]byte, error) {
+	payload, err := base58CheckDecode(serialized)
+	if errors.Is(err, errBase58CheckSum) {
+		return nil, ErrExtendedKeyChecksum
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExtendedKey, err)
+	}
+
+	if len(payload) != extendedKeyLen {
+		return nil, fmt.Errorf("%w: length %d", ErrInvalidExtendedKey, len(payload))
+	}
+
+	return payload, nil
+}
+
+// paramsForHDKeyID returns the networks using version as their private or
+// public HD key ID, and whether it identified private keys.
+func paramsForHDKeyID(version [4]byte) ([]*Params, bool) {
+	var (
+		nets    []*Params
+		private bool
+	)
+
+	for _, p := range knownNets() {
+		switch version {
+		case p.HDPrivateKeyID:
+			nets = append(nets, p)
+			private = true
+		case p.HDPublicKeyID:
+			nets = append(nets, p)
+		}
+	}
+
+	return nets, private
+}
@@ -0,0 +1,92 @@
+package chaincfg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Master keys from BIP32 test vector 1.
+const (
+	bip32Vector1Xpub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	bip32Vector1Xprv = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+)
+
+// TestHDPublicKeyToPrivateKeyID checks the reverse HD key ID lookup.
+func TestHDPublicKeyToPrivateKeyID(t *testing.T) {
+	for _, p := range builtinNets {
+		priv, err := HDPublicKeyToPrivateKeyID(p.HDPublicKeyID[:])
+		require.NoError(t, err, p.Name)
+		assert.Equal(t, p.HDPrivateKeyID[:], priv, p.Name)
+	}
+
+	_, err := HDPublicKeyToPrivateKeyID([]byte{0xff, 0xff, 0xff, 0xff})
+	require.ErrorIs(t, err, ErrUnknownHDKeyID)
+
+	_, err = HDPublicKeyToPrivateKeyID([]byte{0xff})
+	require.ErrorIs(t, err, ErrUnknownHDKeyID)
+}
+
+// TestParamsForExtendedKey checks network detection from version bytes.
+func TestParamsForExtendedKey(t *testing.T) {
+	for _, key := range []string{bip32Vector1Xpub, bip32Vector1Xprv} {
+		nets, err := ParamsForExtendedKey(key)
+		require.NoError(t, err)
+		assert.Equal(t, []*Params{&MainNetParams}, nets)
+	}
+
+	tpub, err := ConvertExtendedKey(bip32Vector1Xpub, &TestNetParams)
+	require.NoError(t, err)
+
+	nets, err := ParamsForExtendedKey(tpub)
+	require.NoError(t, err)
+	assert.Equal(t, []*Params{
+		&TestNetParams, &RegressionNetParams, &StnParams, &TeraTestNetParams, &TeraScalingTestNetParams,
+	}, nets)
+
+	_, err = ParamsForExtendedKey(bip32Vector1Xpub[:len(bip32Vector1Xpub)-1] + "9")
+	require.ErrorIs(t, err, ErrExtendedKeyChecksum)
+
+	_, err = ParamsForExtendedKey(base58CheckEncode(make([]byte, 10)))
+	require.ErrorIs(t, err, ErrInvalidExtendedKey)
+
+	_, err = ParamsForExtendedKey(base58CheckEncode(make([]byte, extendedKeyLen)))
+	require.ErrorIs(t, err, ErrUnknownHDKeyID)
+}
+
+// TestConvertExtendedKey checks conversion between mainnet and test magics.
+func TestConvertExtendedKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		prefix string
+	}{
+		{"xpub to tpub", bip32Vector1Xpub, "tpub"},
+		{"xprv to tprv", bip32Vector1Xprv, "tprv"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, err := ConvertExtendedKey(tc.key, &TeraTestNetParams)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(converted, tc.prefix), converted)
+
+			back, err := ConvertExtendedKey(converted, &MainNetParams)
+			require.NoError(t, err)
+			assert.Equal(t, tc.key, back)
+		})
+	}
+
+	// Public key data labelled with private version bytes is refused.
+	payload, err := base58CheckDecode(bip32Vector1Xpub)
+	require.NoError(t, err)
+	copy(payload[:4], MainNetParams.HDPrivateKeyID[:])
+
+	_, err = ConvertExtendedKey(base58CheckEncode(payload), &TestNetParams)
+	require.ErrorIs(t, err, ErrInvalidExtendedKey)
+
+	_, err = ConvertExtendedKey(bip32Vector1Xpub, nil)
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+}
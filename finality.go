@@ -0,0 +1,80 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrReorgTooDeep describes an error where a reorganization would
+	// disconnect more than MaxReorgDepth blocks.
+	ErrReorgTooDeep = errors.New("reorganization too deep")
+
+	// ErrReorgBelowCheckpoint describes an error where a reorganization would
+	// disconnect a checkpointed block.
+	ErrReorgBelowCheckpoint = errors.New("reorganization below checkpoint")
+)
+
+// LatestCheckpoint returns the highest checkpoint at or below the given
+// height, or nil if there is none.
+func (p *Params) LatestCheckpoint(height int32) *Checkpoint {
+	var latest *Checkpoint
+
+	for i := range p.Checkpoints {
+		cp := &p.Checkpoints[i]
+		if cp.Height <= height && (latest == nil || cp.Height > latest.Height) {
+			latest = cp
+		}
+	}
+
+	return latest
+}
+
+// IsFinal reports whether the block at the given height may be treated as
+// final with the chain tip at tip: it is covered by a checkpoint, or it has
+// at least FinalityDepth confirmations.
+func (p *Params) IsFinal(height, tip int32) bool {
+	if height < 0 || height > tip {
+		return false
+	}
+
+	if cp := p.LatestCheckpoint(tip); cp != nil && height <= cp.Height {
+		return true
+	}
+
+	confirmations := int64(tip) - int64(height) + 1
+
+	return p.FinalityDepth > 0 && confirmations >= int64(p.FinalityDepth)
+}
+
+// CheckReorg checks whether a reorganization from the chain tip at tip back
+// to the common ancestor at forkHeight is allowed.
+//
+// The reorganization is refused when it would disconnect more than
+// MaxReorgDepth blocks, or any block at or below the latest checkpoint.
+//
+// Parameters:
+//
+//	forkHeight - the height of the last block shared by both chains.
+//	tip - the height of the current chain tip.
+//
+// Returns:
+//
+//	error - nil if allowed, otherwise ErrReorgTooDeep or ErrReorgBelowCheckpoint.
+func (p *Params) CheckReorg(forkHeight, tip int32) error {
+	if forkHeight >= tip {
+		return nil
+	}
+
+	if cp := p.LatestCheckpoint(tip); cp != nil && forkHeight < cp.Height {
+		return fmt.Errorf("%w: fork at height %d would disconnect checkpoint %d",
+			ErrReorgBelowCheckpoint, forkHeight, cp.Height)
+	}
+
+	depth := int64(tip) - int64(forkHeight)
+	if p.MaxReorgDepth > 0 && depth > int64(p.MaxReorgDepth) {
+		return fmt.Errorf("%w: %d blocks, maximum %d", ErrReorgTooDeep, depth, p.MaxReorgDepth)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLatestCheckpoint checks checkpoint lookup by height.
+func TestLatestCheckpoint(t *testing.T) {
+	assert.Nil(t, MainNetParams.LatestCheckpoint(11110))
+	assert.Equal(t, int32(11111), MainNetParams.LatestCheckpoint(11111).Height)
+	assert.Equal(t, int32(11111), MainNetParams.LatestCheckpoint(33332).Height)
+
+	last := MainNetParams.Checkpoints[len(MainNetParams.Checkpoints)-1]
+	assert.Equal(t, last, *MainNetParams.LatestCheckpoint(last.Height + 1000))
+
+	assert.Nil(t, RegressionNetParams.LatestCheckpoint(1000000))
+}
+
+// TestIsFinal checks finality by depth and by checkpoint.
+func TestIsFinal(t *testing.T) {
+	last := MainNetParams.Checkpoints[len(MainNetParams.Checkpoints)-1].Height
+	tip := last + 100
+
+	// The built-in networks leave the finality depth to operators, so only
+	// checkpoints make blocks final.
+	for _, p := range builtinNets {
+		assert.Zero(t, p.FinalityDepth, p.Name)
+		assert.Zero(t, p.MaxReorgDepth, p.Name)
+	}
+
+	assert.True(t, MainNetParams.IsFinal(last, tip), "checkpointed")
+	assert.False(t, MainNetParams.IsFinal(tip-50, tip), "no finality depth")
+
+	p := MainNetParams.Clone()
+	p.FinalityDepth = 6
+
+	assert.True(t, p.IsFinal(last, tip), "checkpointed")
+	assert.True(t, p.IsFinal(tip-5, tip), "6 confirmations")
+	assert.False(t, p.IsFinal(tip-4, tip), "5 confirmations")
+	assert.False(t, p.IsFinal(tip+1, tip), "above tip")
+	assert.False(t, p.IsFinal(-1, tip))
+
+	// Regtest has no checkpoints and no finality depth.
+	assert.False(t, RegressionNetParams.IsFinal(0, 100000))
+}
+
+// TestCheckReorg checks the depth limit and checkpoint protection.
+func TestCheckReorg(t *testing.T) {
+	last := MainNetParams.Checkpoints[len(MainNetParams.Checkpoints)-1].Height
+	tip := last + 500
+
+	// Without a depth limit only the checkpoints bound a reorganization.
+	require.NoError(t, MainNetParams.CheckReorg(last, tip))
+	require.ErrorIs(t, MainNetParams.CheckReorg(last-1, last+50), ErrReorgBelowCheckpoint)
+
+	p := MainNetParams.Clone()
+	p.MaxReorgDepth = 100
+
+	require.NoError(t, p.CheckReorg(tip, tip), "no reorg")
+	require.NoError(t, p.CheckReorg(tip-1, tip))
+	require.NoError(t, p.CheckReorg(tip-100, tip))
+	require.ErrorIs(t, p.CheckReorg(tip-101, tip), ErrReorgTooDeep)
+	require.ErrorIs(t, p.CheckReorg(last-1, last+50), ErrReorgBelowCheckpoint)
+	require.NoError(t, p.CheckReorg(last, last+50), "fork at the checkpoint itself")
+
+	// Regtest allows reorgs of any depth.
+	require.NoError(t, RegressionNetParams.CheckReorg(0, 100000))
+}
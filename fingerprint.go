@@ -0,0 +1,167 @@
+package chaincfg
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// consensusFingerprintTag is the domain separator that starts the canonical
+// serialization.  It changes whenever the field list below changes.
+const consensusFingerprintTag = "chaincfg/consensus/v1"
+
+// powLimitLen is the width of the big-endian PowLimit encoding.
+const powLimitLen = 32
+
+// Fingerprint is a SHA-256 digest identifying the consensus rules of a
+// network.
+type Fingerprint [sha256.Size]byte
+
+// String returns the fingerprint in hex, in digest byte order.
+func (f Fingerprint) String() string {
+	return hex.EncodeToString(f[:])
+}
+
+// ConsensusFingerprint returns a stable hash over the consensus-critical
+// fields of the network.
+//
+// Services can publish it at startup so peers and monitoring detect nodes
+// that loaded different rules under the same network name.  Names, aliases,
+// seeds, ports, address encodings, checkpoints, reorg and finality limits and
+// mempool policy do not affect it.  The per-block rules of BIP30Exceptions,
+// InvalidBlocks and RequiredAncestors do, since nodes that disagree on them
+// follow different chains.  The hash is SHA-256 of
+// ConsensusSerialization.
+func (p *Params) ConsensusFingerprint() Fingerprint {
+	return sha256.Sum256(p.ConsensusSerialization())
+}
+
+// ConsensusSerialization returns the canonical encoding hashed by
+// ConsensusFingerprint.
+//
+// Integers are big-endian and fixed width; booleans are one byte, 0 or 1;
+// durations are int64 nanoseconds.  The encoding is, in order:
+//
+//	tag                            uint8 length, then "chaincfg/consensus/v1"
+//	GenesisHash                    32 bytes, internal byte order; zero if nil
+//	PowLimit                       32 bytes, unsigned; zero if nil
+//	PowLimitBits                   uint32
+//	BIP0034Height                  int32
+//	BIP0065Height                  int32
+//	BIP0066Height                  int32
+//	CSVHeight                      uint32
+//	UahfForkHeight                 uint32
+//	DaaForkHeight                  uint32
+//	GenesisActivationHeight        uint32
+//	ChronicleActivationHeight      uint32
+//	ForkIDValue                    uint32
+//	AcceptLegacySigHash            bool
+//	CoinbaseMaturity               uint16
+//	MaxCoinbaseScriptSigSize       uint32
+//	SubsidyReductionInterval       uint32
+//	TargetTimePerBlock             int64
+//	RetargetAdjustmentFactor       int64
+//	ReduceMinDifficulty            bool
+//	NoDifficultyAdjustment         bool
+//	MinDiffReductionTime           int64
+//	MedianTimeBlocks               uint32
+//	MaxFutureBlockTime             int64
+//	RuleChangeActivationThreshold  uint32
+//	MinerConfirmationWindow        uint32
+//	len(Deployments)               uint32
+//	each deployment                BitNumber uint8, StartTime uint64, ExpireTime uint64
+//	len(BIP30Exceptions)           uint32
+//	each exception                 Height int32, Hash 32 bytes; zero if nil
+//	len(InvalidBlocks)             uint32
+//	each invalid block             Height int32, Hash 32 bytes; zero if nil
+//	len(RequiredAncestors)         uint32
+//	each required ancestor         Height int32, Hash 32 bytes; zero if nil
+//
+// Any change to this list must also change consensusFingerprintTag.
+func (p *Params) ConsensusSerialization() []byte {
+	buf := make([]byte, 0, 256)
+
+	buf = append(buf, byte(len(consensusFingerprintTag)))
+	buf = append(buf, consensusFingerprintTag...)
+
+	var genesis [32]byte
+	if p.GenesisHash != nil {
+		genesis = *p.GenesisHash
+	}
+
+	buf = append(buf, genesis[:]...)
+
+	var powLimit [powLimitLen]byte
+	if p.PowLimit != nil {
+		p.PowLimit.FillBytes(powLimit[:])
+	}
+
+	buf = append(buf, powLimit[:]...)
+	buf = binary.BigEndian.AppendUint32(buf, p.PowLimitBits)
+
+	buf = binary.BigEndian.AppendUint32(buf, uint32(p.BIP0034Height)) //nolint:gosec // two's complement is intended
+	buf = binary.BigEndian.AppendUint32(buf, uint32(p.BIP0065Height)) //nolint:gosec // two's complement is intended
+	buf = binary.BigEndian.AppendUint32(buf, uint32(p.BIP0066Height)) //nolint:gosec // two's complement is intended
+	buf = binary.BigEndian.AppendUint32(buf, p.CSVHeight)
+	buf = binary.BigEndian.AppendUint32(buf, p.UahfForkHeight)
+	buf = binary.BigEndian.AppendUint32(buf, p.DaaForkHeight)
+	buf = binary.BigEndian.AppendUint32(buf, p.GenesisActivationHeight)
+	buf = binary.BigEndian.AppendUint32(buf, p.ChronicleActivationHeight)
+	buf = binary.BigEndian.AppendUint32(buf, p.ForkIDValue)
+	buf = appendBool(buf, p.AcceptLegacySigHash)
+
+	buf = binary.BigEndian.AppendUint16(buf, p.CoinbaseMaturity)
+	buf = binary.BigEndian.AppendUint32(buf, p.MaxCoinbaseScriptSigSize)
+	buf = binary.BigEndian.AppendUint32(buf, p.SubsidyReductionInterval)
+
+	buf = binary.BigEndian.AppendUint64(buf, uint64(p.TargetTimePerBlock))       //nolint:gosec // two's complement is intended
+	buf = binary.BigEndian.AppendUint64(buf, uint64(p.RetargetAdjustmentFactor)) //nolint:gosec // two's complement is intended
+	buf = appendBool(buf, p.ReduceMinDifficulty)
+	buf = appendBool(buf, p.NoDifficultyAdjustment)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(p.MinDiffReductionTime)) //nolint:gosec // two's complement is intended
+	buf = binary.BigEndian.AppendUint32(buf, uint32(p.MedianTimeBlocks))     //nolint:gosec // two's complement is intended
+	buf = binary.BigEndian.AppendUint64(buf, uint64(p.MaxFutureBlockTime))   //nolint:gosec // two's complement is intended
+
+	buf = binary.BigEndian.AppendUint32(buf, p.RuleChangeActivationThreshold)
+	buf = binary.BigEndian.AppendUint32(buf, p.MinerConfirmationWindow)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(p.Deployments)))
+
+	for _, d := range p.Deployments {
+		buf = append(buf, d.BitNumber)
+		buf = binary.BigEndian.AppendUint64(buf, d.StartTime)
+		buf = binary.BigEndian.AppendUint64(buf, d.ExpireTime)
+	}
+
+	buf = appendCheckpoints(buf, p.BIP30Exceptions)
+	buf = appendCheckpoints(buf, p.InvalidBlocks)
+	buf = appendCheckpoints(buf, p.RequiredAncestors)
+
+	return buf
+}
+
+// appendCheckpoints appends the length of cps followed by the height and hash
+// of each, in list order.
+func appendCheckpoints(buf []byte, cps []Checkpoint) []byte {
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(cps))) //nolint:gosec // lists are short
+
+	for _, cp := range cps {
+		var hash [32]byte
+		if cp.Hash != nil {
+			hash = *cp.Hash
+		}
+
+		buf = binary.BigEndian.AppendUint32(buf, uint32(cp.Height)) //nolint:gosec // two's complement is intended
+		buf = append(buf, hash[:]...)
+	}
+
+	return buf
+}
+
+// appendBool appends b as a single 0 or 1 byte.
+func appendBool(buf []byte, b bool) []byte {
+	if b {
+		return append(buf, 1)
+	}
+
+	return append(buf, 0)
+}
@@ -0,0 +1,77 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConsensusFingerprintStable pins the mainnet fingerprint so accidental
+// changes to the canonical serialization or to mainnet consensus fields are
+// caught.
+func TestConsensusFingerprintStable(t *testing.T) {
+	assert.Equal(t, "35e18d57971f3d5895ef4bae294c1f89a0bb3f611c3ef679733b313423260d55",
+		MainNetParams.ConsensusFingerprint().String())
+}
+
+// TestConsensusFingerprintUnique ensures every built-in network has its own
+// fingerprint.
+func TestConsensusFingerprintUnique(t *testing.T) {
+	seen := make(map[Fingerprint]string)
+
+	for _, p := range builtinNets {
+		fp := p.ConsensusFingerprint()
+		if other, ok := seen[fp]; ok {
+			t.Errorf("%s and %s share fingerprint %s", p.Name, other, fp)
+		}
+
+		seen[fp] = p.Name
+	}
+}
+
+// TestConsensusFingerprintFields checks which fields affect the fingerprint.
+func TestConsensusFingerprintFields(t *testing.T) {
+	base := MainNetParams.ConsensusFingerprint()
+
+	nonConsensus := MainNetParams
+	nonConsensus.Name = "renamed"
+	nonConsensus.Aliases = nil
+	nonConsensus.DNSSeeds = nil
+	nonConsensus.DefaultPort = "1"
+	nonConsensus.Checkpoints = nil
+	nonConsensus.MaxReorgDepth = 100
+	nonConsensus.FinalityDepth = 6
+	nonConsensus.CashAddressPrefix = "other"
+	nonConsensus.RelayNonStdTxs = !nonConsensus.RelayNonStdTxs
+	assert.Equal(t, base, nonConsensus.ConsensusFingerprint())
+
+	tests := map[string]func(p *Params){
+		"ChronicleActivationHeight": func(p *Params) { p.ChronicleActivationHeight++ },
+		"GenesisHash":               func(p *Params) { p.GenesisHash = TestNetParams.GenesisHash },
+		"PowLimit":                  func(p *Params) { p.PowLimit = regressionPowLimit },
+		"SubsidyReductionInterval":  func(p *Params) { p.SubsidyReductionInterval = 150 },
+		"CoinbaseMaturity":          func(p *Params) { p.CoinbaseMaturity = 1 },
+		"ReduceMinDifficulty":       func(p *Params) { p.ReduceMinDifficulty = true },
+		"Deployments":               func(p *Params) { p.Deployments[DeploymentCSV].StartTime++ },
+		"MedianTimeBlocks":          func(p *Params) { p.MedianTimeBlocks = 1 },
+		"ForkIDValue":               func(p *Params) { p.ForkIDValue = 1 },
+		"BIP30Exceptions":           func(p *Params) { p.BIP30Exceptions = p.BIP30Exceptions[:1] },
+		"BIP30Exceptions hash":      func(p *Params) { p.BIP30Exceptions[0].Hash = p.BIP30Exceptions[1].Hash },
+		"InvalidBlocks":             func(p *Params) { p.InvalidBlocks = nil },
+		"InvalidBlocks height":      func(p *Params) { p.InvalidBlocks[0].Height++ },
+		"RequiredAncestors":         func(p *Params) { p.RequiredAncestors = p.RequiredAncestors[1:] },
+	}
+
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			modified := MainNetParams.Clone()
+			mutate(modified)
+			assert.NotEqual(t, base, modified.ConsensusFingerprint())
+		})
+	}
+
+	require.NoError(t, VerifyBuiltinsUnmodified())
+
+	assert.NotPanics(t, func() { (&Params{}).ConsensusFingerprint() })
+}
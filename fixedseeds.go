@@ -0,0 +1,23 @@
+// Code generated by seedgen from cmd/seedgen/nodes. DO NOT EDIT.
+
+package chaincfg
+
+import "net/netip"
+
+// mainNetFixedSeeds are the fixed seed nodes for mainnet.
+var mainNetFixedSeeds = []netip.AddrPort{}
+
+// testNetFixedSeeds are the fixed seed nodes for testnet.
+var testNetFixedSeeds = []netip.AddrPort{}
+
+// regressionNetFixedSeeds are the fixed seed nodes for regtest.
+var regressionNetFixedSeeds = []netip.AddrPort{}
+
+// stnFixedSeeds are the fixed seed nodes for stn.
+var stnFixedSeeds = []netip.AddrPort{}
+
+// teraTestNetFixedSeeds are the fixed seed nodes for teratestnet.
+var teraTestNetFixedSeeds = []netip.AddrPort{}
+
+// teraScalingTestNetFixedSeeds are the fixed seed nodes for tstn.
+var teraScalingTestNetFixedSeeds = []netip.AddrPort{}
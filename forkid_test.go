@@ -0,0 +1,60 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-bt/v2/sighash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRequiresForkID checks the replay protection boundary on each network.
+func TestRequiresForkID(t *testing.T) {
+	for _, p := range builtinNets {
+		fork := uint32Height(p.UahfForkHeight)
+
+		assert.Equal(t, uint32(0), p.ForkID(), p.Name)
+		assert.False(t, p.RequiresForkID(fork), p.Name)
+		assert.True(t, p.RequiresForkID(fork+1), p.Name)
+		assert.False(t, p.IsForkIDEnabled(fork), p.Name)
+		assert.True(t, p.IsForkIDEnabled(fork+1), p.Name)
+	}
+
+	legacy := Params{UahfForkHeight: 10, AcceptLegacySigHash: true}
+	assert.True(t, legacy.IsForkIDEnabled(11))
+	assert.False(t, legacy.RequiresForkID(11))
+}
+
+// TestCheckSigHashType checks FORKID enforcement around the mainnet UAHF.
+func TestCheckSigHashType(t *testing.T) {
+	const before, after = 478558, 478559
+
+	require.NoError(t, MainNetParams.CheckSigHashType(sighash.All, before))
+	require.NoError(t, MainNetParams.CheckSigHashType(sighash.AllForkID, after))
+	require.NoError(t, MainNetParams.CheckSigHashType(sighash.SingleForkID|sighash.AnyOneCanPay, after))
+	require.ErrorIs(t, MainNetParams.CheckSigHashType(sighash.All, after), ErrMissingForkID)
+	require.ErrorIs(t, MainNetParams.CheckSigHashType(sighash.AllForkID, before), ErrIllegalForkID)
+
+	legacy := MainNetParams
+	legacy.AcceptLegacySigHash = true
+	require.NoError(t, legacy.CheckSigHashType(sighash.All, after))
+	require.NoError(t, legacy.CheckSigHashType(sighash.AllForkID, after))
+}
+
+// TestSigningSigHash checks the flag a signer should use.
+func TestSigningSigHash(t *testing.T) {
+	assert.Equal(t, sighash.AllForkID, MainNetParams.SigningSigHash(sighash.All, 800000))
+	assert.Equal(t, sighash.AllForkID, MainNetParams.SigningSigHash(sighash.AllForkID, 800000))
+	assert.Equal(t, sighash.All, MainNetParams.SigningSigHash(sighash.AllForkID, 100))
+	assert.Equal(t, sighash.NoneForkID|sighash.AnyOneCanPay, TestNetParams.SigningSigHash(sighash.None|sighash.AnyOneCanPay, 1155876))
+}
+
+// TestSigHashPreimageType checks the preimage encoding of the sighash type.
+func TestSigHashPreimageType(t *testing.T) {
+	assert.Equal(t, uint32(0x41), MainNetParams.SigHashPreimageType(sighash.AllForkID))
+	assert.Equal(t, uint32(0x01), MainNetParams.SigHashPreimageType(sighash.All))
+
+	forked := Params{ForkIDValue: 0xabcdef}
+	assert.Equal(t, uint32(0xabcdefc1), forked.SigHashPreimageType(sighash.AllForkID|sighash.AnyOneCanPay))
+	assert.Equal(t, uint32(0x03), forked.SigHashPreimageType(sighash.Single))
+}
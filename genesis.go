@@ -176,8 +176,9 @@ var stnGenesisHash = chainhash.Hash([chainhash.HashSize]byte{ // Make go vet hap
 // network.
 var stnGenesisMerkleRoot = genesisMerkleRoot
 
-// regTestGenesisBlock defines the genesis block of the blockchain which serves
-// as the public transaction ledger for the regression test network.
+// stnGenesisBlock defines the genesis block of the blockchain which serves as
+// the public transaction ledger for the stn network.  It is the testnet
+// genesis block, so it shares the testnet nonce.
 var stnGenesisBlock = wire.MsgBlock{
 	Header: wire.BlockHeader{
 		Version:    1,
@@ -185,7 +186,7 @@ var stnGenesisBlock = wire.MsgBlock{
 		MerkleRoot: stnGenesisMerkleRoot,     // 4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b
 		Timestamp:  time.Unix(1296688602, 0), // 2011-02-02 23:16:42 +0000 UTC
 		Bits:       0x1d00ffff,               // 545259519 [7fffff0000000000000000000000000000000000000000000000000000000000]
-		Nonce:      0x0a5bac18,               // 414098458
+		Nonce:      0x18aea41a,               // 414098458
 	},
 	Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
 }
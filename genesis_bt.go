@@ -0,0 +1,85 @@
+package chaincfg
+
+import (
+	"bytes"
+
+	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/bscript"
+	"github.com/bsv-blockchain/go-wire"
+)
+
+// GenesisCoinbaseTx returns the coinbase transaction of the genesis block as
+// a go-bt transaction, or nil if the network has no genesis block.  Each call
+// returns a new value.
+func (p *Params) GenesisCoinbaseTx() *bt.Tx {
+	coinbase := p.genesisCoinbase()
+	if coinbase == nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := coinbase.Serialize(&buf); err != nil {
+		return nil
+	}
+
+	tx, err := bt.NewTxFromBytes(buf.Bytes())
+	if err != nil {
+		return nil
+	}
+
+	return tx
+}
+
+// GenesisHeaderBytes returns the 80-byte serialized header of the genesis
+// block, or nil if the network has no genesis block.  Its double SHA-256 is
+// the GenesisHash.
+func (p *Params) GenesisHeaderBytes() []byte {
+	if p.GenesisBlock == nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := p.GenesisBlock.Header.Serialize(&buf); err != nil {
+		return nil
+	}
+
+	return buf.Bytes()
+}
+
+// GenesisMessage returns the headline embedded in the genesis coinbase
+// scriptSig, for example "The Times 03/Jan/2009 Chancellor on brink of second
+// bailout for banks".  It is the last data push of the scriptSig, and is ""
+// if the network has no genesis block or the scriptSig does not parse.
+func (p *Params) GenesisMessage() string {
+	coinbase := p.genesisCoinbase()
+	if coinbase == nil || len(coinbase.TxIn) == 0 {
+		return ""
+	}
+
+	parts, err := bscript.DecodeParts(coinbase.TxIn[0].SignatureScript)
+	if err != nil || len(parts) == 0 {
+		return ""
+	}
+
+	return string(parts[len(parts)-1])
+}
+
+// GenesisOutputScript returns a copy of the locking script of the first
+// genesis coinbase output, or nil if the network has no genesis block.
+func (p *Params) GenesisOutputScript() *bscript.Script {
+	coinbase := p.genesisCoinbase()
+	if coinbase == nil || len(coinbase.TxOut) == 0 {
+		return nil
+	}
+
+	return bscript.NewFromBytes(bytes.Clone(coinbase.TxOut[0].PkScript))
+}
+
+// genesisCoinbase returns the first transaction of the genesis block, or nil.
+func (p *Params) genesisCoinbase() *wire.MsgTx {
+	if p.GenesisBlock == nil || len(p.GenesisBlock.Transactions) == 0 {
+		return nil
+	}
+
+	return p.GenesisBlock.Transactions[0]
+}
@@ -0,0 +1,73 @@
+package chaincfg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenesisBTAccessors ensures the go-bt views of every built-in genesis
+// block agree with the wire encoding.
+func TestGenesisBTAccessors(t *testing.T) {
+	const (
+		satoshiHeadline = "The Times 03/Jan/2009 Chancellor on brink of second bailout for banks"
+		tstnHeadline    = "The Times 01/Jul/2026 Starmer puts Burnham in £5bn defence black hole"
+	)
+
+	messages := map[string]string{
+		MainNetParams.Name:            satoshiHeadline,
+		TestNetParams.Name:            satoshiHeadline,
+		RegressionNetParams.Name:      satoshiHeadline,
+		StnParams.Name:                satoshiHeadline,
+		TeraTestNetParams.Name:        satoshiHeadline,
+		TeraScalingTestNetParams.Name: tstnHeadline,
+	}
+	require.Len(t, messages, len(builtinNets))
+
+	for _, p := range builtinNets {
+		t.Run(p.Name, func(t *testing.T) {
+			wireTx := p.GenesisBlock.Transactions[0]
+
+			var wireBytes bytes.Buffer
+			require.NoError(t, wireTx.Serialize(&wireBytes))
+
+			tx := p.GenesisCoinbaseTx()
+			require.NotNil(t, tx)
+			assert.Equal(t, wireBytes.Bytes(), tx.Bytes())
+			assert.Equal(t, wireTx.TxHash().String(), tx.TxID())
+			assert.Equal(t, p.GenesisBlock.Header.MerkleRoot.String(), tx.TxID())
+			assert.True(t, tx.IsCoinbase())
+			require.Len(t, tx.Outputs, 1)
+			assert.Equal(t, uint64(wireTx.TxOut[0].Value), tx.Outputs[0].Satoshis)
+
+			header := p.GenesisBlock.Header
+			headerBytes := p.GenesisHeaderBytes()
+			require.Len(t, headerBytes, 80)
+			assert.Equal(t, header.BlockHash(), chainhash.DoubleHashH(headerBytes))
+			assert.Equal(t, *p.GenesisHash, chainhash.DoubleHashH(headerBytes))
+
+			assert.Equal(t, messages[p.Name], p.GenesisMessage())
+
+			script := p.GenesisOutputScript()
+			require.NotNil(t, script)
+			assert.Equal(t, wireTx.TxOut[0].PkScript, []byte(*script))
+			assert.Equal(t, tx.Outputs[0].LockingScript, script)
+			assert.True(t, script.IsP2PK())
+
+			// The returned values are copies.
+			(*script)[0] ^= 0xff
+			tx.Version = 2
+			assert.Equal(t, wireTx.TxOut[0].PkScript, []byte(*p.GenesisOutputScript()))
+			assert.Equal(t, uint32(1), p.GenesisCoinbaseTx().Version)
+		})
+	}
+
+	var empty Params
+	assert.Nil(t, empty.GenesisCoinbaseTx())
+	assert.Nil(t, empty.GenesisHeaderBytes())
+	assert.Empty(t, empty.GenesisMessage())
+	assert.Nil(t, empty.GenesisOutputScript())
+}
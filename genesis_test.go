@@ -89,6 +89,30 @@ func TestTestNetGenesisBlock(t *testing.T) {
 	}
 }
 
+// TestStnGenesisBlock tests the genesis block of the stn network for validity
+// by checking the encoded bytes and hashes.  stn reuses the testnet genesis
+// block, so its header must carry the testnet nonce 0x18aea41a.
+func TestStnGenesisBlock(t *testing.T) {
+	// Encode the genesis block to raw bytes.
+	var buf bytes.Buffer
+
+	err := StnParams.GenesisBlock.Serialize(&buf)
+	if err != nil {
+		t.Fatalf("TestStnGenesisBlock: %v", err)
+	}
+
+	// Ensure the encoded block matches the expected bytes.
+	if !bytes.Equal(buf.Bytes(), testNetGenesisBlockBytes) {
+		t.Fatalf(genesisBlockInvalidFmt, "TestStnGenesisBlock", spew.Sdump(buf.Bytes()), spew.Sdump(testNetGenesisBlockBytes))
+	}
+
+	// Check the hash of the block against the expected hash.
+	hash := StnParams.GenesisBlock.BlockHash()
+	if !StnParams.GenesisHash.IsEqual(&hash) {
+		t.Fatalf(genesisBlockHashInvalidFmt, "TestStnGenesisBlock", spew.Sdump(hash), spew.Sdump(StnParams.GenesisHash))
+	}
+}
+
 // TestGenesisCoinbase tests the genesis coinbase transaction of the main
 func TestGenesisCoinbase(t *testing.T) {
 	expectedCoinbaseHex := "01000000010000000000000000000000000000000000000000000000000000000000000000ffffffff4d04ffff001d0104455468652054696d65732030332f4a616e2f32303039204368616e63656c6c6f72206f6e206272696e6b206f66207365636f6e64206261696c6f757420666f722062616e6b73ffffffff0100f2052a01000000434104678afdb0fe5548271967f1a67130b7105cd6a828e03909a67962e0ea1f61deb649f6bc3f4cef38c4f35504e51ec112de5c384df7ba0b8d578a4c702b6bf11d5fac00000000"
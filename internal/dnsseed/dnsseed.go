@@ -0,0 +1,105 @@
+// Package dnsseed resolves DNS seeds into peer addresses.  It holds the
+// concurrent lookup behind the public seeds package.
+package dnsseed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/bsv-blockchain/go-wire"
+)
+
+// ErrNoSeeds describes an error where a lookup was requested for a network
+// without any DNS seeds.
+var ErrNoSeeds = errors.New("network has no DNS seeds")
+
+// Seed is a DNS seed host and whether it understands service-flag subdomains.
+type Seed struct {
+	Host         string
+	HasFiltering bool
+}
+
+// Resolver resolves a host name to IP addresses.  *net.Resolver satisfies it.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// SeedHost returns the name to query for a seed.  Filtering seeds are asked
+// for peers advertising requiredServices through the "x<hex>." subdomain
+// convention; other seeds, and lookups without required services, use the
+// bare host.
+func SeedHost(seed Seed, requiredServices wire.ServiceFlag) string {
+	if seed.HasFiltering && requiredServices != 0 {
+		return fmt.Sprintf("x%x.%s", uint64(requiredServices), seed.Host)
+	}
+
+	return seed.Host
+}
+
+// Lookup queries every seed in parallel, each bounded by timeout, and returns
+// the de-duplicated addresses on port in seed order.  An error is returned
+// only when no seed produced an address; it joins the per-seed failures.
+func Lookup(ctx context.Context, resolver Resolver, seeds []Seed, port int,
+	requiredServices wire.ServiceFlag, timeout time.Duration,
+) ([]*net.TCPAddr, error) {
+	if len(seeds) == 0 {
+		return nil, ErrNoSeeds
+	}
+
+	results := make([][]net.IPAddr, len(seeds))
+	errs := make([]error, len(seeds))
+
+	var wg sync.WaitGroup
+
+	for i, seed := range seeds {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			lookupCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			host := SeedHost(seed, requiredServices)
+
+			ips, err := resolver.LookupIPAddr(lookupCtx, host)
+			if err != nil {
+				errs[i] = fmt.Errorf("seed %s: %w", host, err)
+				return
+			}
+
+			results[i] = ips
+		}()
+	}
+
+	wg.Wait()
+
+	seen := make(map[string]struct{})
+
+	var addrs []*net.TCPAddr
+
+	for _, ips := range results {
+		for _, ip := range ips {
+			key := ip.String()
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+
+			addrs = append(addrs, &net.TCPAddr{IP: ip.IP, Port: port, Zone: ip.Zone})
+		}
+	}
+
+	if len(addrs) == 0 {
+		if err := errors.Join(errs...); err != nil {
+			return nil, err
+		}
+	}
+
+	return addrs, nil
+}
@@ -0,0 +1,90 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+)
+
+// ErrForbiddenChain describes an error where a chain contains one of the
+// network's InvalidBlocks or lacks one of its RequiredAncestors.
+var ErrForbiddenChain = errors.New("chain is forbidden on this network")
+
+// IsKnownInvalid reports whether the block hash is one of the network's
+// InvalidBlocks.
+func (p *Params) IsKnownInvalid(hash *chainhash.Hash) bool {
+	if hash == nil {
+		return false
+	}
+
+	for _, b := range p.InvalidBlocks {
+		if b.Hash != nil && b.Hash.IsEqual(hash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckChainBlock checks a single block of a candidate chain against the
+// network's InvalidBlocks and RequiredAncestors.
+//
+// Header sync calls it for each header as it is connected, so a node never
+// follows a rejected chain even before reaching the next checkpoint.
+//
+// Parameters:
+//
+//	height - the block height.
+//	hash - the block hash.
+//
+// Returns:
+//
+//	error - nil if allowed, otherwise ErrForbiddenChain.
+func (p *Params) CheckChainBlock(height int32, hash *chainhash.Hash) error {
+	if p.IsKnownInvalid(hash) {
+		return fmt.Errorf("%w: block %s at height %d is known invalid", ErrForbiddenChain, hash, height)
+	}
+
+	for _, r := range p.RequiredAncestors {
+		if r.Height == height && r.Hash != nil && (hash == nil || !r.Hash.IsEqual(hash)) {
+			return fmt.Errorf("%w: block at height %d must be %s, got %v", ErrForbiddenChain, height, r.Hash, hash)
+		}
+	}
+
+	return nil
+}
+
+// CheckCandidateChain checks a whole candidate chain up to tipHeight against
+// the network's InvalidBlocks and RequiredAncestors.  Only the headers at
+// the listed heights are fetched.
+//
+// Parameters:
+//
+//	src - the headers of the candidate chain.
+//	tipHeight - the height of the candidate tip.
+//
+// Returns:
+//
+//	error - nil if allowed, ErrForbiddenChain, or any error from src.
+func (p *Params) CheckCandidateChain(src HeaderSource, tipHeight int32) error {
+	for _, list := range [][]Checkpoint{p.InvalidBlocks, p.RequiredAncestors} {
+		for _, b := range list {
+			if b.Height > tipHeight {
+				continue
+			}
+
+			header, err := src.HeaderByHeight(b.Height)
+			if err != nil {
+				return fmt.Errorf("header at height %d: %w", b.Height, err)
+			}
+
+			hash := header.BlockHash()
+			if err := p.CheckChainBlock(b.Height, &hash); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
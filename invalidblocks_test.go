@@ -0,0 +1,87 @@
+package chaincfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// headerMap is a sparse HeaderSource.
+type headerMap map[int32]*wire.BlockHeader
+
+func (m headerMap) HeaderByHeight(height int32) (*wire.BlockHeader, error) {
+	h, ok := m[height]
+	if !ok {
+		return nil, errNoHeader
+	}
+
+	return h, nil
+}
+
+// TestInvalidBlocksData checks the mainnet fork data for consistency.
+func TestInvalidBlocksData(t *testing.T) {
+	require.Len(t, MainNetParams.InvalidBlocks, 2)
+	require.Len(t, MainNetParams.RequiredAncestors, 2)
+
+	for i, invalid := range MainNetParams.InvalidBlocks {
+		required := MainNetParams.RequiredAncestors[i]
+		assert.Equal(t, invalid.Height, required.Height)
+		assert.NotEqual(t, invalid.Hash, required.Hash)
+		assert.True(t, MainNetParams.IsKnownInvalid(invalid.Hash))
+		assert.False(t, MainNetParams.IsKnownInvalid(required.Hash))
+
+		require.NoError(t, MainNetParams.CheckChainBlock(required.Height, required.Hash))
+		require.ErrorIs(t, MainNetParams.CheckChainBlock(invalid.Height, invalid.Hash), ErrForbiddenChain)
+	}
+
+	// The UAHF split is the block after UahfForkHeight.
+	assert.Equal(t, uint32Height(MainNetParams.UahfForkHeight)+1, MainNetParams.InvalidBlocks[0].Height)
+
+	// Required ancestors agree with any checkpoint at the same height.
+	for _, r := range MainNetParams.RequiredAncestors {
+		for _, cp := range MainNetParams.Checkpoints {
+			if cp.Height == r.Height {
+				assert.Equal(t, cp.Hash, r.Hash)
+			}
+		}
+	}
+
+	assert.False(t, MainNetParams.IsKnownInvalid(nil))
+	assert.False(t, TestNetParams.IsKnownInvalid(MainNetParams.InvalidBlocks[0].Hash))
+}
+
+// TestCheckCandidateChain checks whole-chain verification with synthetic
+// headers.
+func TestCheckCandidateChain(t *testing.T) {
+	header := func(nonce uint32) *wire.BlockHeader {
+		return &wire.BlockHeader{Version: 1, Timestamp: time.Unix(1700000000, 0), Nonce: nonce}
+	}
+	hash := func(h *wire.BlockHeader) *chainhash.Hash {
+		b := h.BlockHash()
+		return &b
+	}
+
+	good, bad, other := header(1), header(2), header(3)
+
+	p := Params{
+		InvalidBlocks:     []Checkpoint{{10, hash(bad)}},
+		RequiredAncestors: []Checkpoint{{20, hash(good)}},
+	}
+
+	require.NoError(t, p.CheckCandidateChain(headerMap{10: other, 20: good}, 30))
+	require.ErrorIs(t, p.CheckCandidateChain(headerMap{10: bad, 20: good}, 30), ErrForbiddenChain)
+	require.ErrorIs(t, p.CheckCandidateChain(headerMap{10: other, 20: other}, 30), ErrForbiddenChain)
+
+	// Entries above the tip are not checked yet.
+	require.NoError(t, p.CheckCandidateChain(headerMap{10: other}, 15))
+
+	require.ErrorIs(t, p.CheckCandidateChain(headerMap{}, 15), errNoHeader)
+
+	// A known-invalid block is rejected at any height.
+	require.ErrorIs(t, p.CheckChainBlock(11, hash(bad)), ErrForbiddenChain)
+	require.ErrorIs(t, p.CheckChainBlock(20, nil), ErrForbiddenChain)
+}
@@ -0,0 +1,172 @@
+package chaincfg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/bsv-blockchain/go-wire"
+)
+
+var (
+	// ErrNetworkMismatch describes an error where a peer announces the magic
+	// of a different network than the local node runs.
+	ErrNetworkMismatch = errors.New("network mismatch")
+
+	// ErrMagicByteOrder describes an error where a peer sends the magic of
+	// the right network in reversed byte order.
+	ErrMagicByteOrder = errors.New("network magic in reversed byte order")
+
+	// ErrAmbiguousNetwork describes an error where a lookup matches more than
+	// one network, such as the genesis block shared by testnet and stn.
+	ErrAmbiguousNetwork = errors.New("ambiguous network")
+)
+
+// ParamsByNet returns the built-in or registered network identified by the
+// given wire magic.
+//
+// Parameters:
+//
+//	net - the network magic.
+//
+// Returns:
+//
+//	*Params - the matching network.
+//	error - ErrUnknownNetwork if no network uses the magic.
+func ParamsByNet(net wire.BitcoinNet) (*Params, error) {
+	for _, p := range knownNets() {
+		if p.Net == net {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: magic 0x%08x", ErrUnknownNetwork, uint32(net))
+}
+
+// ParamsByGenesisHash returns the built-in or registered network whose
+// genesis block has the given hash.
+//
+// Networks may share a genesis block: stn reuses the testnet genesis.  Such a
+// hash does not identify a network, so ErrAmbiguousNetwork is returned rather
+// than guessing; use NetworksByGenesisHash for the candidates, or ParamsByNet
+// when the wire magic is available to tell them apart.
+//
+// Parameters:
+//
+//	hash - the genesis block hash.
+//
+// Returns:
+//
+//	*Params - the matching network.
+//	error - ErrUnknownNetwork if no network has that genesis block, or
+//	        ErrAmbiguousNetwork if more than one has.
+func ParamsByGenesisHash(hash *chainhash.Hash) (*Params, error) {
+	if hash == nil {
+		return nil, fmt.Errorf("%w: nil genesis hash", ErrUnknownNetwork)
+	}
+
+	nets := NetworksByGenesisHash(hash)
+
+	switch len(nets) {
+	case 0:
+		return nil, fmt.Errorf("%w: genesis %s", ErrUnknownNetwork, hash)
+	case 1:
+		return nets[0], nil
+	}
+
+	names := make([]string, 0, len(nets))
+	for _, p := range nets {
+		names = append(names, p.Name)
+	}
+
+	return nil, fmt.Errorf("%w: genesis %s is shared by %s", ErrAmbiguousNetwork, hash, strings.Join(names, ", "))
+}
+
+// NetworksByGenesisHash returns every built-in or registered network whose
+// genesis block has the given hash, in lookup order, or nil if none has.
+func NetworksByGenesisHash(hash *chainhash.Hash) []*Params {
+	if hash == nil {
+		return nil
+	}
+
+	var nets []*Params
+
+	for _, p := range knownNets() {
+		if p.GenesisHash != nil && p.GenesisHash.IsEqual(hash) {
+			nets = append(nets, p)
+		}
+	}
+
+	return nets
+}
+
+// IdentifyNetwork returns the network whose magic matches four bytes read
+// from the wire.
+//
+// Magics are sent little-endian.  A peer that writes them big-endian is a
+// common misconfiguration, so when the little-endian reading matches nothing
+// the reversed reading is tried and reported through swapped.
+//
+// Parameters:
+//
+//	rawMagic - the first four bytes of a message header, as received.
+//
+// Returns:
+//
+//	*Params - the matching network.
+//	bool - true when the magic only matched in reversed byte order.
+//	error - ErrUnknownNetwork if neither byte order matches a network.
+func IdentifyNetwork(rawMagic [4]byte) (params *Params, swapped bool, err error) {
+	if p, err := ParamsByNet(wire.BitcoinNet(binary.LittleEndian.Uint32(rawMagic[:]))); err == nil {
+		return p, false, nil
+	}
+
+	if p, err := ParamsByNet(wire.BitcoinNet(binary.BigEndian.Uint32(rawMagic[:]))); err == nil {
+		return p, true, nil
+	}
+
+	return nil, false, fmt.Errorf("%w: magic bytes %x", ErrUnknownNetwork, rawMagic[:])
+}
+
+// CheckPeerMagic checks the magic received from a peer against the local
+// network and explains any mismatch, for example "peer is talking testnet to
+// a mainnet node".
+//
+// Parameters:
+//
+//	local - the network this node runs.
+//	rawMagic - the first four bytes of a message header, as received.
+//
+// Returns:
+//
+//	error - nil when the peer is on the local network; otherwise ErrNetworkMismatch,
+//	        ErrMagicByteOrder or ErrUnknownNetwork with a descriptive message.
+//	        ErrUnknownNetwork is also returned when local is nil.
+func CheckPeerMagic(local *Params, rawMagic [4]byte) error {
+	if local == nil {
+		return fmt.Errorf("%w: nil local network", ErrUnknownNetwork)
+	}
+
+	remote, swapped, err := IdentifyNetwork(rawMagic)
+	if err != nil {
+		return fmt.Errorf("peer is on an unknown network, this node is on %s: %w", local.Name, err)
+	}
+
+	if remote.Net != local.Net {
+		order := ""
+		if swapped {
+			order = " (with reversed byte order)"
+		}
+
+		return fmt.Errorf("%w: peer is talking %s%s to a %s node", ErrNetworkMismatch, remote.Name, order, local.Name)
+	}
+
+	if swapped {
+		return fmt.Errorf("%w: peer sent the %s magic %x, expected %x",
+			ErrMagicByteOrder, local.Name, rawMagic[:], binary.LittleEndian.AppendUint32(nil, uint32(local.Net)))
+	}
+
+	return nil
+}
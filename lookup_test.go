@@ -0,0 +1,73 @@
+package chaincfg
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParamsByNetAndGenesisHash checks both lookups for every built-in network.
+func TestParamsByNetAndGenesisHash(t *testing.T) {
+	for _, p := range builtinNets {
+		got, err := ParamsByNet(p.Net)
+		require.NoError(t, err, p.Name)
+		assert.Same(t, p, got)
+
+		assert.Contains(t, NetworksByGenesisHash(p.GenesisHash), p, p.Name)
+	}
+
+	// stn shares the testnet genesis block, so the hash alone is ambiguous.
+	_, err := ParamsByGenesisHash(StnParams.GenesisHash)
+	require.ErrorIs(t, err, ErrAmbiguousNetwork)
+	assert.Contains(t, err.Error(), "testnet, stn")
+	assert.Equal(t, []*Params{&TestNetParams, &StnParams}, NetworksByGenesisHash(StnParams.GenesisHash))
+
+	for _, p := range []*Params{&MainNetParams, &RegressionNetParams, &TeraTestNetParams, &TeraScalingTestNetParams} {
+		got, err := ParamsByGenesisHash(p.GenesisHash)
+		require.NoError(t, err, p.Name)
+		assert.Same(t, p, got)
+	}
+
+	_, err = ParamsByNet(wire.BitcoinNet(0x12345678))
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+
+	_, err = ParamsByGenesisHash(newHashFromStr("00"))
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+
+	_, err = ParamsByGenesisHash(nil)
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+	assert.Nil(t, NetworksByGenesisHash(nil))
+}
+
+// TestIdentifyNetwork checks both byte orders and the peer mismatch errors.
+func TestIdentifyNetwork(t *testing.T) {
+	var le, be [4]byte
+
+	binary.LittleEndian.PutUint32(le[:], uint32(wire.TestNet))
+	binary.BigEndian.PutUint32(be[:], uint32(wire.TestNet))
+
+	p, swapped, err := IdentifyNetwork(le)
+	require.NoError(t, err)
+	assert.Same(t, &TestNetParams, p)
+	assert.False(t, swapped)
+
+	p, swapped, err = IdentifyNetwork(be)
+	require.NoError(t, err)
+	assert.Same(t, &TestNetParams, p)
+	assert.True(t, swapped)
+
+	_, _, err = IdentifyNetwork([4]byte{0x12, 0x34, 0x56, 0x78})
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+
+	require.NoError(t, CheckPeerMagic(&TestNetParams, le))
+	require.ErrorIs(t, CheckPeerMagic(&TestNetParams, be), ErrMagicByteOrder)
+	require.ErrorIs(t, CheckPeerMagic(&MainNetParams, [4]byte{0x12, 0x34, 0x56, 0x78}), ErrUnknownNetwork)
+	require.ErrorIs(t, CheckPeerMagic(nil, le), ErrUnknownNetwork)
+
+	err = CheckPeerMagic(&MainNetParams, le)
+	require.ErrorIs(t, err, ErrNetworkMismatch)
+	assert.Contains(t, err.Error(), "peer is talking testnet to a mainnet node")
+}
@@ -0,0 +1,110 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/bsv-blockchain/go-wire"
+)
+
+var (
+	// ErrNoMedianTimeBlocks describes an error where the median time past is
+	// requested for a network with no MedianTimeBlocks.
+	ErrNoMedianTimeBlocks = errors.New("median time blocks not set")
+
+	// ErrTimeTooOld describes an error where a block timestamp is not after
+	// the median time past of the previous blocks.
+	ErrTimeTooOld = errors.New("block timestamp is not after median time past")
+
+	// ErrTimeTooNew describes an error where a block timestamp is further in
+	// the future than MaxFutureBlockTime allows.
+	ErrTimeTooNew = errors.New("block timestamp too far in the future")
+)
+
+// HeaderSource provides block headers by height from the active chain.
+type HeaderSource interface {
+	// HeaderByHeight returns the header of the block at the given height.
+	HeaderByHeight(height int32) (*wire.BlockHeader, error)
+}
+
+// CalcPastMedianTime returns the median time past (MTP) of the block at the
+// given height: the median timestamp of that block and the
+// MedianTimeBlocks-1 blocks before it, or of all blocks back to genesis when
+// there are fewer.
+//
+// A new block at height h must have a timestamp after the MTP at h-1, and
+// from CSVHeight on lock times are evaluated against it (BIP0113).
+//
+// Parameters:
+//
+//	src - the source of headers.
+//	height - the height of the last block included.
+//
+// Returns:
+//
+//	time.Time - the median time past.
+//	error - ErrNoMedianTimeBlocks, or any error from src.
+func (p *Params) CalcPastMedianTime(src HeaderSource, height int32) (time.Time, error) {
+	if p.MedianTimeBlocks <= 0 {
+		return time.Time{}, ErrNoMedianTimeBlocks
+	}
+
+	timestamps := make([]int64, 0, p.MedianTimeBlocks)
+
+	for h := height; h >= 0 && len(timestamps) < p.MedianTimeBlocks; h-- {
+		header, err := src.HeaderByHeight(h)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("header at height %d: %w", h, err)
+		}
+
+		timestamps = append(timestamps, header.Timestamp.Unix())
+	}
+
+	if len(timestamps) == 0 {
+		return time.Time{}, fmt.Errorf("%w: no blocks at height %d", ErrNoMedianTimeBlocks, height)
+	}
+
+	slices.Sort(timestamps)
+
+	return time.Unix(timestamps[len(timestamps)/2], 0), nil
+}
+
+// CheckBlockTimestamp checks a block header's timestamp against the median
+// time past of its parent and the local clock.
+//
+// Parameters:
+//
+//	header - the header to check.
+//	mtp - the median time past of the parent block.
+//	now - the current adjusted time.
+//	params - the network parameters.
+//
+// Returns:
+//
+//	error - nil if valid, otherwise ErrTimeTooOld or ErrTimeTooNew.
+func CheckBlockTimestamp(header *wire.BlockHeader, mtp, now time.Time, params *Params) error {
+	if !header.Timestamp.After(mtp) {
+		return fmt.Errorf("%w: timestamp %s, median time past %s",
+			ErrTimeTooOld, header.Timestamp.UTC().Format(time.RFC3339), mtp.UTC().Format(time.RFC3339))
+	}
+
+	if limit := now.Add(params.MaxFutureBlockTime); header.Timestamp.After(limit) {
+		return fmt.Errorf("%w: timestamp %s, latest allowed %s",
+			ErrTimeTooNew, header.Timestamp.UTC().Format(time.RFC3339), limit.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// LockTimeCutoff returns the time against which transaction lock times are
+// evaluated in a block at the given height: the median time past of its
+// parent from CSVHeight on (BIP0113), and the block's own timestamp before.
+func (p *Params) LockTimeCutoff(height int32, blockTime, mtp time.Time) time.Time {
+	if height >= 0 && uint32(height) >= p.CSVHeight {
+		return mtp
+	}
+
+	return blockTime
+}
@@ -0,0 +1,97 @@
+package chaincfg
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNoHeader = errors.New("no header")
+
+// headerSlice is a HeaderSource backed by a slice indexed by height.
+type headerSlice []*wire.BlockHeader
+
+func (s headerSlice) HeaderByHeight(height int32) (*wire.BlockHeader, error) {
+	if height < 0 || int(height) >= len(s) {
+		return nil, errNoHeader
+	}
+
+	return s[height], nil
+}
+
+// newHeaderSlice returns headers with the given Unix timestamps.
+func newHeaderSlice(timestamps ...int64) headerSlice {
+	headers := make(headerSlice, len(timestamps))
+	for i, ts := range timestamps {
+		headers[i] = &wire.BlockHeader{Timestamp: time.Unix(ts, 0)}
+	}
+
+	return headers
+}
+
+// TestCalcPastMedianTime checks the median over the last MedianTimeBlocks
+// headers and near genesis.
+func TestCalcPastMedianTime(t *testing.T) {
+	// Out-of-order timestamps, as allowed by consensus.
+	src := newHeaderSlice(100, 700, 200, 600, 300, 500, 400, 1000, 800, 900, 1100, 50, 1200)
+
+	tests := []struct {
+		height int32
+		median int64
+	}{
+		{0, 100},
+		{1, 700},
+		{2, 200},
+		{3, 600},
+		{10, 600},
+		{11, 600},
+		{12, 600},
+	}
+
+	for _, test := range tests {
+		mtp, err := MainNetParams.CalcPastMedianTime(src, test.height)
+		require.NoError(t, err)
+		assert.Equal(t, test.median, mtp.Unix(), "height %d", test.height)
+	}
+
+	_, err := MainNetParams.CalcPastMedianTime(src, 13)
+	require.ErrorIs(t, err, errNoHeader)
+
+	_, err = MainNetParams.CalcPastMedianTime(src, -1)
+	require.ErrorIs(t, err, ErrNoMedianTimeBlocks)
+
+	_, err = (&Params{}).CalcPastMedianTime(src, 5)
+	require.ErrorIs(t, err, ErrNoMedianTimeBlocks)
+
+	for _, p := range builtinNets {
+		assert.Equal(t, 11, p.MedianTimeBlocks, p.Name)
+		assert.Equal(t, 2*time.Hour, p.MaxFutureBlockTime, p.Name)
+	}
+}
+
+// TestCheckBlockTimestamp checks both timestamp bounds.
+func TestCheckBlockTimestamp(t *testing.T) {
+	mtp := time.Unix(1700000000, 0)
+	now := mtp.Add(time.Hour)
+
+	header := func(ts time.Time) *wire.BlockHeader { return &wire.BlockHeader{Timestamp: ts} }
+
+	require.NoError(t, CheckBlockTimestamp(header(mtp.Add(time.Second)), mtp, now, &MainNetParams))
+	require.NoError(t, CheckBlockTimestamp(header(now.Add(2*time.Hour)), mtp, now, &MainNetParams))
+	require.ErrorIs(t, CheckBlockTimestamp(header(mtp), mtp, now, &MainNetParams), ErrTimeTooOld)
+	require.ErrorIs(t, CheckBlockTimestamp(header(mtp.Add(-time.Second)), mtp, now, &MainNetParams), ErrTimeTooOld)
+	require.ErrorIs(t, CheckBlockTimestamp(header(now.Add(2*time.Hour+time.Second)), mtp, now, &MainNetParams), ErrTimeTooNew)
+}
+
+// TestLockTimeCutoff checks the BIP0113 switch at CSVHeight.
+func TestLockTimeCutoff(t *testing.T) {
+	blockTime, mtp := time.Unix(2000, 0), time.Unix(1000, 0)
+
+	assert.Equal(t, blockTime, MainNetParams.LockTimeCutoff(419327, blockTime, mtp))
+	assert.Equal(t, mtp, MainNetParams.LockTimeCutoff(419328, blockTime, mtp))
+	assert.Equal(t, blockTime, MainNetParams.LockTimeCutoff(-1, blockTime, mtp))
+}
@@ -0,0 +1,81 @@
+package chaincfg
+
+// Network holds a selected network and can be decoded directly from a
+// command-line flag, environment variable or config file.
+//
+// It implements flag.Value, encoding.TextMarshaler and
+// encoding.TextUnmarshaler, so it works with flag.Var and with JSON, YAML and
+// env decoders that honor those interfaces.  Names are resolved through
+// GetChainParams and therefore accept aliases in any case; the canonical Name
+// is written back out.  The zero value selects no network and is written and
+// read back as empty text, so an unset config field round-trips.
+type Network struct {
+	params *Params
+}
+
+// NetworkFor returns a Network selecting the given parameters.
+func NetworkFor(params *Params) Network {
+	return Network{params: params}
+}
+
+// ParseNetwork resolves a network name or alias into a Network.
+//
+// Parameters:
+//
+//	name - the network name or alias, in any case.
+//
+// Returns:
+//
+//	Network - the selected network.
+//	error - an ErrUnknownNetwork error listing the valid names.
+func ParseNetwork(name string) (Network, error) {
+	params, err := GetChainParams(name)
+	if err != nil {
+		return Network{}, err
+	}
+
+	return Network{params: params}, nil
+}
+
+// Params returns the selected network parameters, or nil for the zero value.
+func (n Network) Params() *Params {
+	return n.params
+}
+
+// String returns the canonical name of the selected network, or "" for the
+// zero value.
+func (n Network) String() string {
+	if n.params == nil {
+		return ""
+	}
+
+	return n.params.Name
+}
+
+// Set implements flag.Value.
+func (n *Network) Set(s string) error {
+	parsed, err := ParseNetwork(s)
+	if err != nil {
+		return err
+	}
+
+	*n = parsed
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (n Network) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.  Empty text decodes to
+// the zero value, matching what MarshalText writes for it.
+func (n *Network) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*n = Network{}
+		return nil
+	}
+
+	return n.Set(string(text))
+}
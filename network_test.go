@@ -0,0 +1,98 @@
+package chaincfg
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetChainParamsAliases checks case-insensitive names and aliases.
+func TestGetChainParamsAliases(t *testing.T) {
+	tests := []struct {
+		input string
+		want  *Params
+	}{
+		{"MainNet", &MainNetParams},
+		{"main", &MainNetParams},
+		{"TEST", &TestNetParams},
+		{"testnet3", &TestNetParams},
+		{"Regression", &RegressionNetParams},
+		{"STN", &StnParams},
+		{"teranode-testnet", &TeraTestNetParams},
+		{"TSTN", &TeraScalingTestNetParams},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := GetChainParams(tc.input)
+			require.NoError(t, err)
+			assert.Same(t, tc.want, got)
+		})
+	}
+
+	_, err := GetChainParams("bogus")
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+	assert.Contains(t, err.Error(), "mainnet, testnet, regtest, stn, teratestnet, tstn")
+}
+
+// TestNetworkFlag checks Network as a flag.Value.
+func TestNetworkFlag(t *testing.T) {
+	var network Network
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&network, "network", "network to use")
+
+	require.NoError(t, fs.Parse([]string{"-network", "Test"}))
+	assert.Same(t, &TestNetParams, network.Params())
+	assert.Equal(t, "testnet", network.String())
+
+	require.Error(t, fs.Parse([]string{"-network", "bogus"}))
+	assert.Same(t, &TestNetParams, network.Params(), "a failed Set must not change the value")
+}
+
+// TestNetworkText checks Network in JSON config round trips.
+func TestNetworkText(t *testing.T) {
+	type config struct {
+		Network Network `json:"network"`
+	}
+
+	var cfg config
+
+	require.NoError(t, json.Unmarshal([]byte(`{"network":"teranode-testnet"}`), &cfg))
+	assert.Same(t, &TeraTestNetParams, cfg.Network.Params())
+
+	out, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"network":"teratestnet"}`, string(out))
+
+	require.ErrorIs(t, json.Unmarshal([]byte(`{"network":"nope"}`), &cfg), ErrUnknownNetwork)
+
+	var zero Network
+
+	assert.Empty(t, zero.String())
+	assert.Nil(t, zero.Params())
+
+	// An unset field round-trips through its empty text.
+	out, err = json.Marshal(config{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"network":""}`, string(out))
+
+	cfg = config{Network: NetworkFor(&MainNetParams)}
+	require.NoError(t, json.Unmarshal(out, &cfg))
+	assert.Equal(t, zero, cfg.Network)
+
+	// A Network value, not just a pointer, is a fmt.Stringer.
+	var stringer fmt.Stringer = NetworkFor(&RegressionNetParams)
+	assert.Equal(t, "regtest", stringer.String())
+	assert.Equal(t, "regtest", fmt.Sprint(NetworkFor(&RegressionNetParams)))
+
+	text, err := NetworkFor(&StnParams).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "stn", string(text))
+}
@@ -0,0 +1,252 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bsv-blockchain/go-wire"
+)
+
+var (
+	// ErrInvalidOverride describes an error where an override spec is not a
+	// known key=value pair or its value is out of range.
+	ErrInvalidOverride = errors.New("invalid parameter override")
+
+	// ErrMainNetOverride describes an error where overrides are applied to
+	// the main network without being forced.
+	ErrMainNetOverride = errors.New("refusing to override main network parameters")
+)
+
+// overrideSetters maps each override key to the function that parses and
+// applies its value.
+var overrideSetters = map[string]func(p *Params, value string) error{
+	"bip34":            setInt32Height(func(p *Params) *int32 { return &p.BIP0034Height }),
+	"bip65":            setInt32Height(func(p *Params) *int32 { return &p.BIP0065Height }),
+	"bip66":            setInt32Height(func(p *Params) *int32 { return &p.BIP0066Height }),
+	"csv":              setUint32(func(p *Params) *uint32 { return &p.CSVHeight }, 0),
+	"uahf":             setUint32(func(p *Params) *uint32 { return &p.UahfForkHeight }, 0),
+	"daa":              setUint32(func(p *Params) *uint32 { return &p.DaaForkHeight }, 0),
+	"genesis":          setUint32(func(p *Params) *uint32 { return &p.GenesisActivationHeight }, 0),
+	"chronicle":        setUint32(func(p *Params) *uint32 { return &p.ChronicleActivationHeight }, 0),
+	"subsidyhalving":   setUint32(func(p *Params) *uint32 { return &p.SubsidyReductionInterval }, 1),
+	"coinbasematurity": setCoinbaseMaturity,
+}
+
+// OverrideOption configures ApplyOverrides.
+type OverrideOption func(*overrideOptions)
+
+// overrideOptions holds the settings changed by OverrideOption values.
+type overrideOptions struct {
+	force bool
+	name  string
+	net   wire.BitcoinNet
+}
+
+// WithForce allows ApplyOverrides to change the main network parameters.
+func WithForce() OverrideOption {
+	return func(o *overrideOptions) {
+		o.force = true
+	}
+}
+
+// WithNetwork gives the overridden parameters their own name and wire magic,
+// so they can be passed to Register next to the network they derive from.
+func WithNetwork(name string, net wire.BitcoinNet) OverrideOption {
+	return func(o *overrideOptions) {
+		o.name = name
+		o.net = net
+	}
+}
+
+// OverrideKeys returns the keys accepted by ApplyOverrides, sorted.
+func OverrideKeys() []string {
+	keys := make([]string, 0, len(overrideSetters))
+	for k := range overrideSetters {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// ApplyOverrides returns a copy of base with activation heights and related
+// values replaced, in the style of SV Node's regtest command-line options.
+//
+// Each spec has the form key=value, for example "genesis=50", "chronicle=75",
+// "csv=0", "coinbasematurity=10" or "subsidyhalving=20"; see OverrideKeys for
+// the full list.  Keys are case-insensitive and may appear only once.  base is
+// not modified.
+//
+// Records tied to a fork height that an override moves are dropped from the
+// copy, since they describe blocks of the chain base follows: the
+// ActivationBlocks entry of every upgrade whose height changed and, when the
+// UAHF height changed, the InvalidBlocks and RequiredAncestors entries for the
+// split at the block after it.  Other entries are kept.
+//
+// With WithNetwork the copy gets the given name and magic, no aliases, and a
+// TopicPrefix for the new name, and is ready to Register.  Without it the copy
+// keeps the identity of base and is for local use only; Register refuses it
+// when base is a built-in network.
+//
+// Parameters:
+//
+//	base - the parameters to start from.
+//	specs - the key=value overrides.
+//	opts - WithForce to allow overriding the main network, WithNetwork to
+//	       give the copy its own identity.
+//
+// Returns:
+//
+//	*Params - a deep copy of base with the overrides applied.
+//	error - ErrInvalidOverride, ErrMainNetOverride, or ErrDuplicateNet if the
+//	        WithNetwork name or magic is already in use.
+func ApplyOverrides(base *Params, specs []string, opts ...OverrideOption) (*Params, error) {
+	var o overrideOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if base == nil {
+		return nil, ErrUnknownNetwork
+	}
+
+	if base.Net == wire.MainNet && len(specs) > 0 && !o.force {
+		return nil, ErrMainNetOverride
+	}
+
+	p := base.Clone()
+
+	if o.name != "" || o.net != 0 {
+		if err := setNetwork(p, o.name, o.net); err != nil {
+			return nil, err
+		}
+	}
+
+	seen := make(map[string]struct{}, len(specs))
+
+	for _, spec := range specs {
+		key, value, ok := strings.Cut(spec, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if !ok || key == "" || value == "" {
+			return nil, fmt.Errorf("%w: %q is not key=value", ErrInvalidOverride, spec)
+		}
+
+		set, known := overrideSetters[key]
+		if !known {
+			return nil, fmt.Errorf("%w: unknown key %q (valid keys: %s)",
+				ErrInvalidOverride, key, strings.Join(OverrideKeys(), ", "))
+		}
+
+		if _, dup := seen[key]; dup {
+			return nil, fmt.Errorf("%w: %q given more than once", ErrInvalidOverride, key)
+		}
+
+		seen[key] = struct{}{}
+
+		if err := set(p, value); err != nil {
+			return nil, fmt.Errorf("%w: %s=%s: %w", ErrInvalidOverride, key, value, err)
+		}
+	}
+
+	dropMovedForkBlocks(base, p)
+
+	return p, nil
+}
+
+// dropMovedForkBlocks removes from p the block records that are tied to a fork
+// height p no longer shares with base.
+func dropMovedForkBlocks(base, p *Params) {
+	for u := range p.ActivationBlocks {
+		oldHeight, _ := base.ActivationHeight(u)
+		if newHeight, _ := p.ActivationHeight(u); newHeight != oldHeight {
+			delete(p.ActivationBlocks, u)
+		}
+	}
+
+	if p.UahfForkHeight == base.UahfForkHeight {
+		return
+	}
+
+	// The chains split at the first block after the last shared one.
+	split := uint32Height(base.UahfForkHeight) + 1
+	atSplit := func(c Checkpoint) bool { return c.Height == split }
+
+	p.InvalidBlocks = slices.DeleteFunc(p.InvalidBlocks, atSplit)
+	p.RequiredAncestors = slices.DeleteFunc(p.RequiredAncestors, atSplit)
+}
+
+// setNetwork gives p a new name and magic, checking that neither is used by a
+// built-in or registered network.
+func setNetwork(p *Params, name string, net wire.BitcoinNet) error {
+	if name == "" {
+		return fmt.Errorf("%w: empty network name", ErrInvalidOverride)
+	}
+
+	for _, known := range knownNets() {
+		if known.Net == net || known.matchesName(name) {
+			return fmt.Errorf("%w: %s (0x%08x) collides with %s", ErrDuplicateNet, name, uint32(net), known.Name)
+		}
+	}
+
+	p.Name = name
+	p.Net = net
+	p.Aliases = nil
+
+	if len(p.ProtocolVersions) > 0 {
+		p.TopicPrefix = TopicPrefixFor(p.ProtocolVersions[0], name)
+	}
+
+	return nil
+}
+
+// setUint32 returns a setter parsing a uint32 no lower than minValue into the
+// field selected by field.
+func setUint32(field func(*Params) *uint32, minValue uint64) func(*Params, string) error {
+	return func(p *Params, value string) error {
+		n, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return err
+		}
+
+		if n < minValue {
+			return fmt.Errorf("must be at least %d", minValue)
+		}
+
+		*field(p) = uint32(n)
+
+		return nil
+	}
+}
+
+// setInt32Height returns a setter parsing a non-negative int32 height into
+// the field selected by field.
+func setInt32Height(field func(*Params) *int32) func(*Params, string) error {
+	return func(p *Params, value string) error {
+		n, err := strconv.ParseUint(value, 10, 31)
+		if err != nil {
+			return err
+		}
+
+		*field(p) = int32(n)
+
+		return nil
+	}
+}
+
+// setCoinbaseMaturity parses and applies a coinbase maturity override.
+func setCoinbaseMaturity(p *Params, value string) error {
+	n, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return err
+	}
+
+	p.CoinbaseMaturity = uint16(n)
+
+	return nil
+}
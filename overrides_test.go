@@ -0,0 +1,138 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApplyOverrides checks that overrides are applied to a copy.
+func TestApplyOverrides(t *testing.T) {
+	p, err := ApplyOverrides(&RegressionNetParams, []string{
+		"genesis=50", "Chronicle=75", "csv=0", " coinbasematurity = 10 ", "subsidyhalving=20", "bip34=2",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(50), p.GenesisActivationHeight)
+	assert.Equal(t, uint32(75), p.ChronicleActivationHeight)
+	assert.Equal(t, uint32(0), p.CSVHeight)
+	assert.Equal(t, uint16(10), p.CoinbaseMaturity)
+	assert.Equal(t, uint32(20), p.SubsidyReductionInterval)
+	assert.Equal(t, int32(2), p.BIP0034Height)
+	assert.Len(t, Diff(&RegressionNetParams, p), 6)
+
+	require.NoError(t, VerifyBuiltinsUnmodified())
+
+	assert.Equal(t, RegressionNetParams.Name, p.Name)
+	assert.Equal(t, RegressionNetParams.Net, p.Net)
+
+	none, err := ApplyOverrides(&MainNetParams, nil)
+	require.NoError(t, err)
+	assert.Empty(t, Diff(&MainNetParams, none))
+}
+
+// TestApplyOverridesErrors checks spec validation and the main network guard.
+func TestApplyOverridesErrors(t *testing.T) {
+	tests := map[string][]string{
+		"no equals":       {"genesis"},
+		"empty value":     {"genesis="},
+		"empty key":       {"=5"},
+		"unknown key":     {"segwit=1"},
+		"duplicate":       {"csv=1", "CSV=2"},
+		"negative":        {"chronicle=-1"},
+		"not a number":    {"genesis=soon"},
+		"uint32 overflow": {"genesis=4294967296"},
+		"uint16 overflow": {"coinbasematurity=65536"},
+		"int32 overflow":  {"bip34=2147483648"},
+		"zero halving":    {"subsidyhalving=0"},
+	}
+
+	for name, specs := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ApplyOverrides(&RegressionNetParams, specs)
+			require.ErrorIs(t, err, ErrInvalidOverride)
+		})
+	}
+
+	_, err := ApplyOverrides(&MainNetParams, []string{"chronicle=1"})
+	require.ErrorIs(t, err, ErrMainNetOverride)
+
+	p, err := ApplyOverrides(&MainNetParams, []string{"chronicle=1"}, WithForce())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), p.ChronicleActivationHeight)
+	assert.NotEqual(t, uint32(1), MainNetParams.ChronicleActivationHeight)
+
+	_, err = ApplyOverrides(nil, nil)
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+
+	_, err = ApplyOverrides(&RegressionNetParams, nil, WithNetwork("", 0x12345678))
+	require.ErrorIs(t, err, ErrInvalidOverride)
+
+	for _, opt := range []OverrideOption{
+		WithNetwork("regtest2", wire.RegTestNet),
+		WithNetwork("Regtest", 0x12345678),
+		WithNetwork("testnet3", 0x12345678),
+	} {
+		_, err = ApplyOverrides(&RegressionNetParams, nil, opt)
+		require.ErrorIs(t, err, ErrDuplicateNet)
+	}
+}
+
+// TestApplyOverridesNetwork checks that WithNetwork makes the copy
+// registrable and that a copy keeping a built-in identity is refused.
+func TestApplyOverridesNetwork(t *testing.T) {
+	p, err := ApplyOverrides(&TestNetParams, []string{"genesis=50"}, WithNetwork("testnet-fast", 0x12345678))
+	require.NoError(t, err)
+	assert.Equal(t, "testnet-fast", p.Name)
+	assert.Equal(t, wire.BitcoinNet(0x12345678), p.Net)
+	assert.Empty(t, p.Aliases)
+	assert.Equal(t, "teranode/bitcoin/1.0.0/testnet-fast", p.TopicPrefix)
+	assert.NotEmpty(t, TestNetParams.Aliases)
+
+	same, err := ApplyOverrides(&RegressionNetParams, []string{"genesis=50"})
+	require.NoError(t, err)
+	require.ErrorIs(t, Register(same), ErrDuplicateNet)
+	assert.True(t, IsPubKeyHashAddrID(wire.RegTestNet, RegressionNetParams.LegacyPubKeyHashAddrID))
+
+	got, err := GetChainParams("regtest")
+	require.NoError(t, err)
+	assert.Same(t, &RegressionNetParams, got)
+}
+
+// TestApplyOverridesForkBlocks checks that records tied to a moved fork height
+// are dropped and the rest are kept.
+func TestApplyOverridesForkBlocks(t *testing.T) {
+	p, err := ApplyOverrides(&TestNetParams, []string{"csv=0", "uahf=10"})
+	require.NoError(t, err)
+
+	assert.NotContains(t, p.ActivationBlocks, UpgradeCSV)
+	assert.NotContains(t, p.ActivationBlocks, UpgradeUAHF)
+	assert.Equal(t, TestNetParams.ActivationBlocks[UpgradeDAA], p.ActivationBlocks[UpgradeDAA])
+	assert.Len(t, p.ActivationBlocks, len(TestNetParams.ActivationBlocks)-2)
+	assert.Contains(t, TestNetParams.ActivationBlocks, UpgradeCSV, "base must keep its records")
+
+	for u, block := range p.ActivationBlocks {
+		height, _ := p.ActivationHeight(u)
+		assert.Equal(t, height, block.Height, u)
+	}
+
+	// Moving the UAHF drops the records of the split after it, but not those
+	// of the later November 2018 split.
+	mainnet, err := ApplyOverrides(&MainNetParams, []string{"uahf=10"}, WithForce())
+	require.NoError(t, err)
+
+	assert.NotContains(t, mainnet.ActivationBlocks, UpgradeUAHF)
+	assert.Equal(t, []Checkpoint{MainNetParams.InvalidBlocks[1]}, mainnet.InvalidBlocks)
+	assert.Equal(t, []Checkpoint{MainNetParams.RequiredAncestors[1]}, mainnet.RequiredAncestors)
+	assert.Len(t, MainNetParams.InvalidBlocks, 2)
+	assert.Len(t, MainNetParams.RequiredAncestors, 2)
+
+	// An override to the same height changes nothing.
+	same, err := ApplyOverrides(&MainNetParams, []string{"uahf=478558"}, WithForce())
+	require.NoError(t, err)
+	assert.Empty(t, Diff(&MainNetParams, same))
+
+	require.NoError(t, VerifyBuiltinsUnmodified())
+}
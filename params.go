@@ -9,11 +9,14 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"net/netip"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/bsv-blockchain/go-bt/v2/chainhash"
 	"github.com/bsv-blockchain/go-wire"
+	"github.com/multiformats/go-multiaddr"
 )
 
 // These variables are the chain proof-of-work limit parameters for each default
@@ -39,9 +42,6 @@ var (
 	// stnPowLimit is the highest proof of work value a Bitcoin block can
 	// have for the scaling test network. It is value 2^224-1.
 	stnPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 224), bigOne)
-
-	// protocolVersion is the version of the protocol used for libp2p topics
-	protocolVersion = "1.0.0"
 )
 
 const (
@@ -115,12 +115,28 @@ type Params struct {
 	// Name defines a human-readable identifier for the network.
 	Name string
 
+	// Aliases defines alternative names accepted by GetChainParams.  Names
+	// and aliases are matched case-insensitively.
+	Aliases []string
+
 	// Net defines the magic bytes used to identify the network.
 	Net wire.BitcoinNet
 
 	// TopicPrefix defines the prefix used for blockchain topics in libP2P.
+	// It must equal TopicPrefixFor(ProtocolVersions[0], Name).
 	TopicPrefix string
 
+	// ProtocolVersions defines the semver libp2p protocol versions spoken on
+	// the network, preferred first.  Listing more than one lets a node
+	// subscribe to the topics of several versions during a migration.
+	ProtocolVersions []string
+
+	// BootstrapPeers defines the libp2p peers dialed to join the network's
+	// gossip.  Each network has its own list so peers on different networks
+	// never bootstrap into each other.  The built-in networks publish no
+	// peers yet, so their lists are empty and operators supply their own.
+	BootstrapPeers []multiaddr.Multiaddr
+
 	// DefaultPort defines the default peer-to-peer port for the network.
 	DefaultPort string
 
@@ -128,6 +144,11 @@ type Params struct {
 	// as one method to discover peers.
 	DNSSeeds []DNSSeed
 
+	// FixedSeeds defines hard-coded peer addresses used as a fallback when
+	// the DNS seeds fail or are absent.  The lists are generated by
+	// cmd/seedgen.
+	FixedSeeds []netip.AddrPort
+
 	// GenesisBlock defines the first block of the chain.
 	GenesisBlock *wire.MsgBlock
 
@@ -157,6 +178,15 @@ type Params struct {
 	GenesisActivationHeight   uint32 // Genesis activation height
 	ChronicleActivationHeight uint32 // Chronicle activation height
 
+	// ForkIDValue is the replay protection value that SIGHASH_FORKID
+	// signatures commit to in the upper 24 bits of their sighash type.  It
+	// is 0 on every BSV network.
+	ForkIDValue uint32
+
+	// AcceptLegacySigHash keeps signatures without SIGHASH_FORKID valid
+	// after UahfForkHeight.  It is false on every built-in network.
+	AcceptLegacySigHash bool
+
 	// CoinbaseMaturity is the number of blocks required before newly mined
 	// coins (coinbase transactions) can be spent.
 	CoinbaseMaturity uint16
@@ -178,6 +208,15 @@ type Params struct {
 	// block.
 	TargetTimePerBlock time.Duration
 
+	// MedianTimeBlocks is the number of previous blocks whose timestamps
+	// make up the median time past (MTP).  A block's timestamp must be
+	// after the MTP of its parent.
+	MedianTimeBlocks int
+
+	// MaxFutureBlockTime is how far ahead of the local clock a block's
+	// timestamp may be before the block is rejected.
+	MaxFutureBlockTime time.Duration
+
 	// RetargetAdjustmentFactor is the adjustment factor used to limit
 	// the minimum and maximum amount of adjustment that can occur between
 	// difficulty retargets.
@@ -205,6 +244,38 @@ type Params struct {
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints []Checkpoint
 
+	// MaxReorgDepth is the largest number of blocks a reorganization may
+	// disconnect.  Zero means no limit beyond the checkpoints.  It is a node
+	// policy rather than a consensus rule, so the built-in networks leave it
+	// unset and operators choose a value for their deployment.
+	MaxReorgDepth uint32
+
+	// FinalityDepth is the number of confirmations after which services may
+	// treat a block as final.  Zero means blocks only become final once
+	// covered by a checkpoint.  Like MaxReorgDepth it is left unset on the
+	// built-in networks for operators to configure.
+	FinalityDepth uint32
+
+	// BIP30Exceptions lists the blocks whose coinbase transactions duplicate
+	// an earlier unspent coinbase and are exempt from the BIP0030 check,
+	// ordered from oldest to newest.
+	BIP30Exceptions []Checkpoint
+
+	// ActivationBlocks records the block at the activation height of each
+	// upgrade, where known.  Header sync can check these like checkpoints.
+	// They do not identify a side of a chain split: the UAHF entry is the
+	// last block shared with BTC.  InvalidBlocks and RequiredAncestors do.
+	ActivationBlocks map[Upgrade]Checkpoint
+
+	// InvalidBlocks lists blocks that nodes on this network reject
+	// regardless of their validity, such as the first blocks of competing
+	// chains after contentious forks.
+	InvalidBlocks []Checkpoint
+
+	// RequiredAncestors lists blocks that every chain extending past their
+	// height must contain.
+	RequiredAncestors []Checkpoint
+
 	// These fields are related to voting on consensus rule changes as
 	// defined by BIP0009.
 	//
@@ -249,13 +320,17 @@ type Params struct {
 
 // MainNetParams defines the network parameters for the main Bitcoin network.
 var MainNetParams = Params{
-	Name:        "mainnet",
-	Net:         wire.MainNet,
-	TopicPrefix: fmt.Sprintf("teranode/bitcoin/%s/mainnet", protocolVersion),
-	DefaultPort: "8333",
+	Name:             "mainnet",
+	Aliases:          []string{"main"},
+	Net:              wire.MainNet,
+	TopicPrefix:      TopicPrefixFor(DefaultProtocolVersion, "mainnet"),
+	ProtocolVersions: []string{DefaultProtocolVersion},
+	BootstrapPeers:   []multiaddr.Multiaddr{},
+	DefaultPort:      "8333",
 	DNSSeeds: []DNSSeed{
 		{"seed.bitcoinsv.io", true},
 	},
+	FixedSeeds: mainNetFixedSeeds,
 
 	// Chain parameters
 	GenesisBlock:  &genesisBlock,
@@ -279,7 +354,9 @@ var MainNetParams = Params{
 	CoinbaseMaturity:          100,
 	SubsidyReductionInterval:  210000,
 	TargetTimePerBlock:        time.Minute * 10, // 10 minutes
-	RetargetAdjustmentFactor:  4,                // 25% less, 400% more
+	MedianTimeBlocks:          11,
+	MaxFutureBlockTime:        time.Hour * 2, // 2 hours
+	RetargetAdjustmentFactor:  4,             // 25% less, 400% more
 	ReduceMinDifficulty:       false,
 	NoDifficultyAdjustment:    false,
 	MinDiffReductionTime:      0,
@@ -324,6 +401,37 @@ var MainNetParams = Params{
 		{945000, newHashFromStr("00000000000000000c39d94e19d6a55cfb0454918df1814fbcd919353a6e1f82")},
 	},
 
+	// BIP30Exceptions are the two blocks whose coinbases overwrote earlier
+	// unspent coinbases before BIP0030 was enforced.
+	BIP30Exceptions: []Checkpoint{
+		{91842, newHashFromStr("00000000000a4d0a398161ffc163c503763b1f4360639393e0e4c8e300e0caec")},
+		{91880, newHashFromStr("00000000000743f190a18c5577a3c2d2a1f610ae9601ac046a38084ccb7cd721")},
+	},
+
+	// ActivationBlocks are the blocks at the activation heights above.
+	ActivationBlocks: map[Upgrade]Checkpoint{
+		UpgradeBIP34: {227931, newHashFromStr("000000000000024b89b42a942fe0d9fea3bb44ab7bd1b19115dd6a759c0808b8")},
+		UpgradeBIP65: {388381, newHashFromStr("000000000000000004c2b624ed5d7756c508d90fd0da2c7c679febfa6c4735f0")},
+		UpgradeBIP66: {363725, newHashFromStr("00000000000000000379eaa19dce8c9b722d46ae6a57c2f1a988119488b50931")},
+		UpgradeCSV:   {419328, newHashFromStr("000000000000000004a1b34462cb8aeebd5799177f7a29cf28f2d1961716b5b5")},
+		UpgradeUAHF:  {478558, newHashFromStr("0000000000000000011865af4122fe3b144e2cbeea86142e8ff2fb4107352d43")},
+		UpgradeDAA:   {504031, newHashFromStr("0000000000000000011ebf65b60d0a3de80b8175be709d653b4c1a1beeb6ab9c")},
+	},
+
+	// InvalidBlocks are the first blocks of the chains that split from this
+	// one: BTC at the August 2017 UAHF and BCH (ABC) at the November 2018
+	// split.
+	InvalidBlocks: []Checkpoint{
+		{478559, newHashFromStr("00000000000000000019f112ec0a9982926f1258cdcc558dd7c3b7e5dc7fa148")},
+		{556767, newHashFromStr("0000000000000000004626ff6e3b936941d341c5932ece4357eeccac44e6d56c")},
+	},
+
+	// RequiredAncestors are the first blocks of this chain after those splits.
+	RequiredAncestors: []Checkpoint{
+		{478559, newHashFromStr("000000000000000000651ef99cb9fcbe0dadde1d424bd9f15ff20136191a5eec")},
+		{556767, newHashFromStr("000000000000000001d956714215d96ffc00e0afda4cd0a96c96f8d802b1662b")},
+	},
+
 	// Consensus rule change deployments.
 	//
 	// The miner confirmation window is defined as:
@@ -367,11 +475,15 @@ var MainNetParams = Params{
 
 // StnParams defines the network parameters for the scaling test network.
 var StnParams = Params{
-	Name:        "stn",
-	Net:         wire.STN,
-	TopicPrefix: fmt.Sprintf("teranode/bitcoin/%s/stn", protocolVersion),
-	DefaultPort: "9333",
-	DNSSeeds:    []DNSSeed{},
+	Name:             "stn",
+	Aliases:          []string{"scalingtestnet"},
+	Net:              wire.STN,
+	TopicPrefix:      TopicPrefixFor(DefaultProtocolVersion, "stn"),
+	ProtocolVersions: []string{DefaultProtocolVersion},
+	BootstrapPeers:   []multiaddr.Multiaddr{},
+	DefaultPort:      "9333",
+	DNSSeeds:         []DNSSeed{},
+	FixedSeeds:       stnFixedSeeds,
 
 	// Chain parameters
 	GenesisBlock:             &stnGenesisBlock,
@@ -396,7 +508,9 @@ var StnParams = Params{
 
 	SubsidyReductionInterval: 210000,
 	TargetTimePerBlock:       time.Minute * 10, // 10 minutes
-	RetargetAdjustmentFactor: 4,                // 25% less, 400% more
+	MedianTimeBlocks:         11,
+	MaxFutureBlockTime:       time.Hour * 2, // 2 hours
+	RetargetAdjustmentFactor: 4,             // 25% less, 400% more
 	ReduceMinDifficulty:      false,
 	NoDifficultyAdjustment:   false,
 	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
@@ -448,11 +562,15 @@ var StnParams = Params{
 // Bitcoin network.  Not to be confused with the test Bitcoin network (version
 // 3), this network is sometimes simply called "testnet".
 var RegressionNetParams = Params{
-	Name:        "regtest",
-	Net:         wire.RegTestNet,
-	TopicPrefix: fmt.Sprintf("teranode/bitcoin/%s/regtest", protocolVersion),
-	DefaultPort: "18444",
-	DNSSeeds:    []DNSSeed{},
+	Name:             "regtest",
+	Aliases:          []string{"regression"},
+	Net:              wire.RegTestNet,
+	TopicPrefix:      TopicPrefixFor(DefaultProtocolVersion, "regtest"),
+	ProtocolVersions: []string{DefaultProtocolVersion},
+	BootstrapPeers:   []multiaddr.Multiaddr{},
+	DefaultPort:      "18444",
+	DNSSeeds:         []DNSSeed{},
+	FixedSeeds:       regressionNetFixedSeeds,
 
 	// Chain parameters
 	GenesisBlock:             &regTestGenesisBlock,
@@ -477,7 +595,9 @@ var RegressionNetParams = Params{
 
 	SubsidyReductionInterval: 150,
 	TargetTimePerBlock:       time.Minute * 10, // 10 minutes
-	RetargetAdjustmentFactor: 4,                // 25% less, 400% more
+	MedianTimeBlocks:         11,
+	MaxFutureBlockTime:       time.Hour * 2, // 2 hours
+	RetargetAdjustmentFactor: 4,             // 25% less, 400% more
 	ReduceMinDifficulty:      true,
 	NoDifficultyAdjustment:   true,
 	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
@@ -529,13 +649,17 @@ var RegressionNetParams = Params{
 // (version 3).  Not to be confused with the regression test network, this
 // network is sometimes simply called "testnet".
 var TestNetParams = Params{
-	Name:        "testnet",
-	Net:         wire.TestNet,
-	TopicPrefix: fmt.Sprintf("teranode/bitcoin/%s/testnet", protocolVersion),
-	DefaultPort: "18333",
+	Name:             "testnet",
+	Aliases:          []string{"test", "testnet3"},
+	Net:              wire.TestNet,
+	TopicPrefix:      TopicPrefixFor(DefaultProtocolVersion, "testnet"),
+	ProtocolVersions: []string{DefaultProtocolVersion},
+	BootstrapPeers:   []multiaddr.Multiaddr{},
+	DefaultPort:      "18333",
 	DNSSeeds: []DNSSeed{
 		{"testnet-seed.bitcoinsv.io", true},
 	},
+	FixedSeeds: testNetFixedSeeds,
 
 	// Chain parameters
 	GenesisBlock:  &testNetGenesisBlock,
@@ -560,7 +684,9 @@ var TestNetParams = Params{
 
 	SubsidyReductionInterval: 210000,
 	TargetTimePerBlock:       time.Minute * 10, // 10 minutes
-	RetargetAdjustmentFactor: 4,                // 25% less, 400% more
+	MedianTimeBlocks:         11,
+	MaxFutureBlockTime:       time.Hour * 2, // 2 hours
+	RetargetAdjustmentFactor: 4,             // 25% less, 400% more
 	ReduceMinDifficulty:      true,
 	NoDifficultyAdjustment:   false,
 	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
@@ -589,6 +715,16 @@ var TestNetParams = Params{
 		{1730000, newHashFromStr("00000000000e61efa6a236cd94662eefc814a24affb9f24b002ebc4b018e4256")},
 	},
 
+	// ActivationBlocks are the blocks at the activation heights above.
+	ActivationBlocks: map[Upgrade]Checkpoint{
+		UpgradeBIP34: {21111, newHashFromStr("0000000023b3a96d3484e5abb3755c413e7d41500f8e2a5c3f0dd01299cd8ef8")},
+		UpgradeBIP65: {581885, newHashFromStr("00000000007f6655f22f98e72ed80d8b06dc761d5da09df0fa1dc4be4f861eb6")},
+		UpgradeBIP66: {330776, newHashFromStr("000000002104c8c45e99a8853285a3b592602a3ccde2b832481da85e9e4ba182")},
+		UpgradeCSV:   {770112, newHashFromStr("00000000025e930139bac5c6c31a403776da130831ab85be56578f3fa75369bb")},
+		UpgradeUAHF:  {1155875, newHashFromStr("00000000f17c850672894b9a75b63a1e72830bbd5f4c8889b5c1a80e7faef138")},
+		UpgradeDAA:   {1188697, newHashFromStr("0000000000170ed0918077bde7b4d36cc4c91be69fa09211f748240dabe047fb")},
+	},
+
 	// Consensus rule change deployments.
 	//
 	// The miner confirmation window is defined as:
@@ -630,10 +766,14 @@ var TestNetParams = Params{
 
 // TeraTestNetParams defines the network parameters for the tera test network
 var TeraTestNetParams = Params{
-	Name:        "teratestnet",
-	Net:         wire.TeraTestNet,
-	TopicPrefix: fmt.Sprintf("teranode/bitcoin/%s/teratestnet", protocolVersion),
-	DefaultPort: "18333",
+	Name:             "teratestnet",
+	Aliases:          []string{"teranode-testnet", "tera-testnet"},
+	Net:              wire.TeraTestNet,
+	TopicPrefix:      TopicPrefixFor(DefaultProtocolVersion, "teratestnet"),
+	ProtocolVersions: []string{DefaultProtocolVersion},
+	BootstrapPeers:   []multiaddr.Multiaddr{},
+	DefaultPort:      "18333",
+	FixedSeeds:       teraTestNetFixedSeeds,
 
 	// Chain parameters
 	GenesisBlock: &teraTestNetGenesisBlock,
@@ -656,7 +796,9 @@ var TeraTestNetParams = Params{
 
 	SubsidyReductionInterval: 210000,
 	TargetTimePerBlock:       time.Minute * 10, // 10 minutes
-	RetargetAdjustmentFactor: 4,                // 25% less, 400% more
+	MedianTimeBlocks:         11,
+	MaxFutureBlockTime:       time.Hour * 2, // 2 hours
+	RetargetAdjustmentFactor: 4,             // 25% less, 400% more
 	ReduceMinDifficulty:      true,
 	NoDifficultyAdjustment:   false,
 	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
@@ -710,10 +852,14 @@ var TeraTestNetParams = Params{
 
 // TeraScalingTestNetParams defines the network parameters for the teranode scaling test network
 var TeraScalingTestNetParams = Params{
-	Name:        "tstn",
-	Net:         wire.TeraScalingTestNet,
-	TopicPrefix: fmt.Sprintf("teranode/bitcoin/%s/tstn", protocolVersion),
-	DefaultPort: "18333",
+	Name:             "tstn",
+	Aliases:          []string{"teranode-scaling-testnet", "terascalingtestnet"},
+	Net:              wire.TeraScalingTestNet,
+	TopicPrefix:      TopicPrefixFor(DefaultProtocolVersion, "tstn"),
+	ProtocolVersions: []string{DefaultProtocolVersion},
+	BootstrapPeers:   []multiaddr.Multiaddr{},
+	DefaultPort:      "18333",
+	FixedSeeds:       teraScalingTestNetFixedSeeds,
 
 	// Chain parameters
 	GenesisBlock: &scalingTeraTestNetGenesisBlock,
@@ -736,7 +882,9 @@ var TeraScalingTestNetParams = Params{
 
 	SubsidyReductionInterval: 210000,
 	TargetTimePerBlock:       time.Minute * 10, // 10 minutes
-	RetargetAdjustmentFactor: 4,                // 25% less, 400% more
+	MedianTimeBlocks:         11,
+	MaxFutureBlockTime:       time.Hour * 2, // 2 hours
+	RetargetAdjustmentFactor: 4,             // 25% less, 400% more
 	ReduceMinDifficulty:      true,
 	NoDifficultyAdjustment:   false,
 	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
@@ -798,28 +946,92 @@ func (d DNSSeed) String() string {
 //
 // Returns:
 //
-//	error - ErrDuplicateNet if the network is already registered, or another error if the
-//	        parameters are invalid; otherwise, returns nil on success.
+//	error - ErrDuplicateNet if the network is already registered, reuses the magic of a
+//	        built-in network it is not, or reuses the name or an alias of another known
+//	        network, or another error if the parameters are invalid; otherwise, returns
+//	        nil on success.
 //
 // Comments:
 //   - Registering the same network more than once will result in an error.
 //   - This function is not thread-safe and should be called during initialization.
 func Register(params *Params) error {
+	// A copy of a built-in network, such as one made by ApplyOverrides,
+	// would otherwise replace the built-in's entries in the lookup maps.
+	if isBuiltinNet(params.Net) && !slices.Contains(builtinNets, params) {
+		return ErrDuplicateNet
+	}
+
 	if _, ok := registeredNets[params.Net]; ok {
 		return ErrDuplicateNet
 	}
 
+	// A reused name or alias would make GetChainParams and topic parsing
+	// resolve to whichever network is found first.
+	for _, known := range knownNets() {
+		if known == params {
+			continue
+		}
+
+		for _, name := range append([]string{params.Name}, params.Aliases...) {
+			if known.matchesName(name) {
+				return fmt.Errorf("%w: %s collides with %s", ErrDuplicateNet, name, known.Name)
+			}
+		}
+	}
+
 	registeredNets[params.Net] = struct{}{}
+	if !isBuiltinNet(params.Net) {
+		registeredParams = append(registeredParams, params)
+	}
+
 	legacyPubKeyHashAddrIDByNetwork[params.Net] = &params.LegacyPubKeyHashAddrID
 	legacyScriptHashAddrIDByNetwork[params.Net] = &params.LegacyScriptHashAddrID
-	hdPrivToPubKeyIDs[params.HDPrivateKeyID] = [4]byte(params.HDPublicKeyID[:])
-
-	// A valid cashaddress prefix for the given net followed by ':'.
-	cashAddressPrefixesMap[params.Net] = params.CashAddressPrefix + ":"
+	privateKeyIDByNetwork[params.Net] = &params.PrivateKeyID
+	hdPrivToPubKeyIDs[params.HDPrivateKeyID] = params.HDPublicKeyID
+	hdPubToPrivKeyIDs[params.HDPublicKeyID] = params.HDPrivateKeyID
+
+	// A valid cashaddress prefix for the given net followed by ':'. Networks
+	// without a prefix would otherwise register the bare string ":".
+	if params.CashAddressPrefix != "" {
+		cashAddressPrefixesMap[params.Net] = params.CashAddressPrefix + ":"
+	}
 
 	return nil
 }
 
+// builtinNets lists the networks defined by this package in lookup order.
+var builtinNets = []*Params{
+	&MainNetParams,
+	&TestNetParams,
+	&RegressionNetParams,
+	&StnParams,
+	&TeraTestNetParams,
+	&TeraScalingTestNetParams,
+}
+
+// registeredParams holds the networks added through Register that are not
+// built in, in registration order.
+var registeredParams []*Params
+
+// isBuiltinNet reports whether net identifies one of the built-in networks.
+func isBuiltinNet(net wire.BitcoinNet) bool {
+	for _, p := range builtinNets {
+		if p.Net == net {
+			return true
+		}
+	}
+
+	return false
+}
+
+// knownNets returns the built-in networks followed by the registered ones.
+func knownNets() []*Params {
+	nets := make([]*Params, 0, len(builtinNets)+len(registeredParams))
+	nets = append(nets, builtinNets...)
+
+	return append(nets, registeredParams...)
+}
+
 // legacyScriptHashAddrIDByNetwork is a map of legacy script hash address IDs by network.
 var legacyScriptHashAddrIDByNetwork = map[wire.BitcoinNet]*byte{
 	// Mainnet
@@ -862,6 +1074,27 @@ var legacyPubKeyHashAddrIDByNetwork = map[wire.BitcoinNet]*byte{
 	TeraScalingTestNetParams.Net: &TeraScalingTestNetParams.LegacyPubKeyHashAddrID,
 }
 
+// privateKeyIDByNetwork is a map of WIF private key IDs by network.
+var privateKeyIDByNetwork = map[wire.BitcoinNet]*byte{
+	// Mainnet
+	MainNetParams.Net: &MainNetParams.PrivateKeyID,
+
+	// Testnet
+	TestNetParams.Net: &TestNetParams.PrivateKeyID,
+
+	// Regression test
+	RegressionNetParams.Net: &RegressionNetParams.PrivateKeyID,
+
+	// Stn
+	StnParams.Net: &StnParams.PrivateKeyID,
+
+	// Teratestnet
+	TeraTestNetParams.Net: &TeraTestNetParams.PrivateKeyID,
+
+	// TeraScalingTestNet
+	TeraScalingTestNetParams.Net: &TeraScalingTestNetParams.PrivateKeyID,
+}
+
 // cashAddressPrefixesMap is a map of valid cashaddress prefixes.
 var cashAddressPrefixesMap = map[wire.BitcoinNet]string{
 	// Mainnet
@@ -873,8 +1106,7 @@ var cashAddressPrefixesMap = map[wire.BitcoinNet]string{
 	// Regression test
 	RegressionNetParams.Net: RegressionNetParams.CashAddressPrefix + ":",
 
-	// Stn
-	StnParams.Net: StnParams.CashAddressPrefix + ":",
+	// Stn has no cashaddress prefix and is deliberately absent.
 
 	// Teratestnet
 	TeraTestNetParams.Net: TeraTestNetParams.CashAddressPrefix + ":",
@@ -892,6 +1124,16 @@ var hdPrivToPubKeyIDs = map[[4]byte][4]byte{
 	TestNetParams.HDPrivateKeyID: TestNetParams.HDPublicKeyID,
 }
 
+// hdPubToPrivKeyIDs is the reverse of hdPrivToPubKeyIDs, mapping hierarchical deterministic
+// public key IDs to their corresponding private key IDs.
+var hdPubToPrivKeyIDs = map[[4]byte][4]byte{
+	// Mainnet
+	MainNetParams.HDPublicKeyID: MainNetParams.HDPrivateKeyID,
+
+	// Testnet & STN & Regression & Teratestnet & TeraScalingTestNet
+	TestNetParams.HDPublicKeyID: TestNetParams.HDPrivateKeyID,
+}
+
 // IsPubKeyHashAddrID determines if the provided address ID byte matches the legacy
 // public key hash address ID for the specified Bitcoin network.
 //
@@ -936,6 +1178,25 @@ func IsScriptHashAddrID(network wire.BitcoinNet, id byte) bool {
 	return false
 }
 
+// IsPrivateKeyID determines if the provided byte matches the WIF private key
+// ID for the specified Bitcoin network.
+//
+// Parameters:
+//
+//	network - the Bitcoin network identifier (wire.BitcoinNet).
+//	id - the WIF version byte to check.
+//
+// Returns:
+//
+//	bool - true if the ID matches the private key ID for the network, false otherwise.
+func IsPrivateKeyID(network wire.BitcoinNet, id byte) bool {
+	if mapID := privateKeyIDByNetwork[network]; mapID != nil {
+		return *mapID == id
+	}
+
+	return false
+}
+
 // IsCashAddressPrefix reports whether the given prefix is a valid cashaddress prefix
 // for the specified Bitcoin network.
 //
@@ -990,6 +1251,33 @@ func HDPrivateKeyToPublicKeyID(id []byte) ([]byte, error) {
 	return pubBytes[:], nil
 }
 
+// HDPublicKeyToPrivateKeyID converts a 4-byte BIP32 hierarchical deterministic (HD) public key ID
+// to its corresponding private key ID.
+//
+// This is the reverse of HDPrivateKeyToPublicKeyID and uses the same set of known and
+// registered networks.
+//
+// Parameters:
+//
+//	id - a 4-byte slice representing the HD public key ID.
+//
+// Returns:
+//
+//	[]byte - a 4-byte slice containing the corresponding HD private key ID.
+//	error - ErrUnknownHDKeyID if the public key ID is not recognized, or if the input is not 4 bytes long.
+func HDPublicKeyToPrivateKeyID(id []byte) ([]byte, error) {
+	if len(id) != 4 {
+		return nil, ErrUnknownHDKeyID
+	}
+
+	privBytes, ok := hdPubToPrivKeyIDs[[4]byte(id)]
+	if !ok {
+		return nil, ErrUnknownHDKeyID
+	}
+
+	return privBytes[:], nil
+}
+
 // newHashFromStr converts the passed big-endian hex string into a
 // chainhash.Hash.  It only differs from the one available in chainhash in that
 // it panics on an error since it will only (and must only) be called with
@@ -1005,33 +1293,57 @@ func newHashFromStr(hexStr string) *chainhash.Hash {
 
 // GetChainParams returns a pointer to the Params struct for the specified Bitcoin network.
 //
-// Supported values for the `network` parameter are: "mainnet", "testnet", "regtest", "stn", "teratestnet", and "tstn".
-// If the provided network name matches one of these, the corresponding Params are returned.
-// If the network name is unknown, an error is returned indicating the network is not recognized.
+// The name is matched case-insensitively against the Name and Aliases of every built-in
+// and registered network, so "mainnet", "MainNet" and "main" all select the main network.
+// Built-in names are "mainnet", "testnet", "regtest", "stn", "teratestnet", and "tstn".
 //
 // Parameters:
 //
-//	network - the name of the Bitcoin network (e.g., "mainnet", "testnet").
+//	network - the name or alias of the Bitcoin network (e.g., "mainnet", "test").
 //
 // Returns:
 //
 //	*Params - pointer to the network parameters for the specified network.
-//	error - non-nil if the network name is unknown.
+//	error - non-nil if the network name is unknown; the message lists the valid names.
 func GetChainParams(network string) (*Params, error) {
-	switch network {
-	case "mainnet":
-		return &MainNetParams, nil
-	case "testnet":
-		return &TestNetParams, nil
-	case "regtest":
-		return &RegressionNetParams, nil
-	case "stn":
-		return &StnParams, nil
-	case "teratestnet":
-		return &TeraTestNetParams, nil
-	case "tstn":
-		return &TeraScalingTestNetParams, nil
-	default:
-		return nil, fmt.Errorf("%w: %s", ErrUnknownNetwork, network)
+	for _, p := range knownNets() {
+		if p.matchesName(network) {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q (valid networks: %s)", ErrUnknownNetwork, network, strings.Join(NetworkNames(), ", "))
+}
+
+// NetworkNames returns the names of the built-in and registered networks in
+// lookup order.
+func NetworkNames() []string {
+	nets := knownNets()
+
+	names := make([]string, 0, len(nets))
+	for _, p := range nets {
+		names = append(names, p.Name)
+	}
+
+	return names
+}
+
+// matchesName reports whether name is the network's Name or one of its
+// Aliases, ignoring case.
+func (p *Params) matchesName(name string) bool {
+	if name == "" {
+		return false
 	}
+
+	if strings.EqualFold(p.Name, name) {
+		return true
+	}
+
+	for _, alias := range p.Aliases {
+		if strings.EqualFold(alias, name) {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,149 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultProtocolVersion is the libp2p protocol version spoken by the
+// built-in networks.
+const DefaultProtocolVersion = "1.0.0"
+
+// topicPrefixRoot is the namespace shared by every Teranode topic prefix,
+// which has the form teranode/bitcoin/<version>/<network>.
+const topicPrefixRoot = "teranode/bitcoin/"
+
+var (
+	// ErrInvalidProtocolVersion describes an error where a protocol version
+	// is not a MAJOR.MINOR.PATCH semantic version.
+	ErrInvalidProtocolVersion = errors.New("invalid protocol version")
+
+	// ErrInvalidTopicPrefix describes an error where a topic prefix does not
+	// have the form teranode/bitcoin/<version>/<network>.
+	ErrInvalidTopicPrefix = errors.New("invalid topic prefix")
+
+	// ErrIncompatibleProtocol describes an error where two topic prefixes
+	// belong to different networks or incompatible protocol versions.
+	ErrIncompatibleProtocol = errors.New("incompatible protocol")
+)
+
+// TopicPrefixFor returns the libp2p topic prefix for a protocol version and
+// network name.
+func TopicPrefixFor(version, network string) string {
+	return topicPrefixRoot + version + "/" + network
+}
+
+// TopicPrefixes returns the topic prefixes of the network for the given
+// protocol versions, or for its ProtocolVersions when none are given.  A node
+// subscribes to every returned prefix to follow several versions at once.
+func (p *Params) TopicPrefixes(versions ...string) []string {
+	if len(versions) == 0 {
+		versions = p.ProtocolVersions
+	}
+
+	prefixes := make([]string, 0, len(versions))
+	for _, v := range versions {
+		prefixes = append(prefixes, TopicPrefixFor(v, p.Name))
+	}
+
+	return prefixes
+}
+
+// ParseTopicPrefix splits a topic prefix into its protocol version and
+// network name.
+//
+// Parameters:
+//
+//	prefix - a prefix such as "teranode/bitcoin/1.0.0/mainnet".
+//
+// Returns:
+//
+//	string - the protocol version.
+//	string - the network name.
+//	error - ErrInvalidTopicPrefix or ErrInvalidProtocolVersion if the prefix is malformed.
+func ParseTopicPrefix(prefix string) (version, network string, err error) {
+	rest, ok := strings.CutPrefix(prefix, topicPrefixRoot)
+	if !ok {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidTopicPrefix, prefix)
+	}
+
+	version, network, ok = strings.Cut(rest, "/")
+	if !ok || network == "" || strings.Contains(network, "/") {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidTopicPrefix, prefix)
+	}
+
+	if _, err = parseProtocolVersion(version); err != nil {
+		return "", "", err
+	}
+
+	return version, network, nil
+}
+
+// CheckTopicPrefixCompatible reports whether a node using the local topic
+// prefix can gossip with a peer using the remote one.
+//
+// Both prefixes must name the same network and share a major protocol
+// version.  While the major version is 0 the minor version must match too,
+// following semver's rule that anything may change before 1.0.0.
+//
+// Parameters:
+//
+//	local - the topic prefix of this node.
+//	remote - the topic prefix announced by the peer.
+//
+// Returns:
+//
+//	error - nil when compatible, ErrIncompatibleProtocol when not, or a parse error.
+func CheckTopicPrefixCompatible(local, remote string) error {
+	localVersion, localNet, err := ParseTopicPrefix(local)
+	if err != nil {
+		return err
+	}
+
+	remoteVersion, remoteNet, err := ParseTopicPrefix(remote)
+	if err != nil {
+		return err
+	}
+
+	if localNet != remoteNet {
+		return fmt.Errorf("%w: local network %s, remote network %s", ErrIncompatibleProtocol, localNet, remoteNet)
+	}
+
+	l, _ := parseProtocolVersion(localVersion)
+	r, _ := parseProtocolVersion(remoteVersion)
+
+	if l[0] != r[0] || (l[0] == 0 && l[1] != r[1]) {
+		return fmt.Errorf("%w: local version %s, remote version %s", ErrIncompatibleProtocol, localVersion, remoteVersion)
+	}
+
+	return nil
+}
+
+// parseProtocolVersion parses a MAJOR.MINOR.PATCH version, ignoring any
+// pre-release or build suffix.
+func parseProtocolVersion(v string) ([3]uint64, error) {
+	var parts [3]uint64
+
+	core := v
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+
+	fields := strings.Split(core, ".")
+	if len(fields) != len(parts) {
+		return parts, fmt.Errorf("%w: %q", ErrInvalidProtocolVersion, v)
+	}
+
+	for i, f := range fields {
+		n, err := strconv.ParseUint(f, 10, 64)
+		if err != nil || (len(f) > 1 && f[0] == '0') {
+			return parts, fmt.Errorf("%w: %q", ErrInvalidProtocolVersion, v)
+		}
+
+		parts[i] = n
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,84 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProtocolVersions checks the built-in versions and topic prefixes.  The
+// version in each TopicPrefix must be the preferred ProtocolVersions entry,
+// since both fields are set by hand on the built-in networks.
+func TestProtocolVersions(t *testing.T) {
+	for _, p := range builtinNets {
+		require.NotEmpty(t, p.ProtocolVersions, p.Name)
+		assert.Equal(t, TopicPrefixFor(p.ProtocolVersions[0], p.Name), p.TopicPrefix,
+			"%s: TopicPrefix disagrees with ProtocolVersions[0]", p.Name)
+		assert.Equal(t, p.TopicPrefixes()[0], p.TopicPrefix, p.Name)
+
+		version, network, err := ParseTopicPrefix(p.TopicPrefix)
+		require.NoError(t, err)
+		assert.Equal(t, p.ProtocolVersions[0], version)
+		assert.Equal(t, p.Name, network)
+	}
+
+	assert.Equal(t, []string{
+		"teranode/bitcoin/1.0.0/testnet",
+		"teranode/bitcoin/1.1.0/testnet",
+	}, TestNetParams.TopicPrefixes("1.0.0", "1.1.0"))
+}
+
+// TestParseTopicMigration checks that a network listing two protocol
+// versions accepts topics from both.
+func TestParseTopicMigration(t *testing.T) {
+	params := Params{
+		Name:             "migrationnet",
+		TopicPrefix:      TopicPrefixFor("1.0.0", "migrationnet"),
+		ProtocolVersions: []string{"1.0.0", "2.0.0"},
+	}
+
+	registeredParams = append(registeredParams, &params)
+
+	t.Cleanup(func() { registeredParams = registeredParams[:len(registeredParams)-1] })
+
+	for _, v := range params.ProtocolVersions {
+		net, kind, err := ParseTopic(params.TopicForVersion(v, TopicSubtree))
+		require.NoError(t, err)
+		assert.Same(t, &params, net)
+		assert.Equal(t, TopicSubtree, kind)
+	}
+}
+
+// TestCheckTopicPrefixCompatible checks the semver compatibility rules.
+func TestCheckTopicPrefixCompatible(t *testing.T) {
+	tests := []struct {
+		name    string
+		local   string
+		remote  string
+		wantErr error
+	}{
+		{"identical", "teranode/bitcoin/1.0.0/mainnet", "teranode/bitcoin/1.0.0/mainnet", nil},
+		{"minor bump", "teranode/bitcoin/1.0.0/mainnet", "teranode/bitcoin/1.4.2/mainnet", nil},
+		{"pre-release", "teranode/bitcoin/1.0.0/mainnet", "teranode/bitcoin/1.1.0-rc.1/mainnet", nil},
+		{"major bump", "teranode/bitcoin/1.0.0/mainnet", "teranode/bitcoin/2.0.0/mainnet", ErrIncompatibleProtocol},
+		{"zero minor bump", "teranode/bitcoin/0.1.0/mainnet", "teranode/bitcoin/0.2.0/mainnet", ErrIncompatibleProtocol},
+		{"other network", "teranode/bitcoin/1.0.0/mainnet", "teranode/bitcoin/1.0.0/testnet", ErrIncompatibleProtocol},
+		{"bad version", "teranode/bitcoin/1.0/mainnet", "teranode/bitcoin/1.0.0/mainnet", ErrInvalidProtocolVersion},
+		{"leading zero", "teranode/bitcoin/1.0.0/mainnet", "teranode/bitcoin/01.0.0/mainnet", ErrInvalidProtocolVersion},
+		{"bad root", "bitcoin/1.0.0/mainnet", "teranode/bitcoin/1.0.0/mainnet", ErrInvalidTopicPrefix},
+		{"no network", "teranode/bitcoin/1.0.0/mainnet", "teranode/bitcoin/1.0.0", ErrInvalidTopicPrefix},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckTopicPrefixCompatible(tc.local, tc.remote)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
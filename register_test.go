@@ -82,6 +82,23 @@ func (ts *TestSuite) TestRegisterFlow() {
 	})
 }
 
+// TestRegisterNameCollision checks that a network reusing the name or an
+// alias of a known network is refused and left unregistered.
+func (ts *TestSuite) TestRegisterNameCollision() {
+	for _, p := range []*Params{
+		{Name: "stn", Net: 0x11223345},
+		{Name: "STN", Net: 0x11223345},
+		{Name: "collisionnet", Aliases: []string{"mainnet"}, Net: 0x11223345},
+		{Name: "collisionnet", Aliases: []string{"testnet3"}, Net: 0x11223345},
+	} {
+		err := Register(p)
+		ts.Require().ErrorIs(err, ErrDuplicateNet, "%s %v", p.Name, p.Aliases)
+
+		_, err = ParamsByNet(p.Net)
+		ts.Require().ErrorIs(err, ErrUnknownNetwork, "%s %v", p.Name, p.Aliases)
+	}
+}
+
 // assertAddrMagics checks if the address magic IDs and cash address prefixes
 func (ts *TestSuite) assertAddrMagics(p *Params, want bool) {
 	ts.Equal(want, IsPubKeyHashAddrID(p.Net, p.LegacyPubKeyHashAddrID), "P2PKH magic %s", p.Name)
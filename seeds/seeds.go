@@ -0,0 +1,140 @@
+// Package seeds discovers peers for a network by resolving its DNS seeds.
+//
+// Seeds that advertise service-flag filtering are queried through the
+// "x<hex>." subdomain so that only peers offering the required services are
+// returned.  The resolver is injectable, which keeps callers and tests free of
+// real DNS traffic.
+package seeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/bsv-blockchain/go-wire"
+
+	chaincfg "github.com/bsv-blockchain/go-chaincfg"
+	"github.com/bsv-blockchain/go-chaincfg/internal/dnsseed"
+)
+
+// DefaultTimeout bounds the lookup of each individual seed.
+const DefaultTimeout = 10 * time.Second
+
+// ErrNoSeeds describes an error where a lookup was requested for a network
+// without any DNS seeds.
+var ErrNoSeeds = dnsseed.ErrNoSeeds
+
+// Resolver resolves a host name to IP addresses.  *net.Resolver satisfies it,
+// including one whose Dial function targets a local DNS stub.
+type Resolver = dnsseed.Resolver
+
+// Option configures LookupSeeds.
+type Option func(*options)
+
+// options holds the settings applied by Option values.
+type options struct {
+	resolver Resolver
+	timeout  time.Duration
+}
+
+// WithResolver replaces net.DefaultResolver for the lookup.
+func WithResolver(r Resolver) Option {
+	return func(o *options) {
+		o.resolver = r
+	}
+}
+
+// WithTimeout replaces DefaultTimeout as the per-seed lookup timeout.  A
+// value of zero or less keeps DefaultTimeout, since it would otherwise make
+// every lookup expire before it starts.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		if d <= 0 {
+			d = DefaultTimeout
+		}
+
+		o.timeout = d
+	}
+}
+
+// LookupSeeds resolves the DNS seeds of a network into peer addresses.
+//
+// All seeds are queried in parallel.  Filtering seeds are asked only for peers
+// advertising requiredServices; pass 0 to query every seed by its bare host.
+// Duplicate IPs across seeds are returned once, in seed order, on the
+// network's DefaultPort.
+//
+// Parameters:
+//
+//	ctx - bounds the whole lookup; each seed is additionally bounded by the timeout option.
+//	params - the network whose DNSSeeds and DefaultPort are used.
+//	requiredServices - the service flags peers must advertise.
+//	opts - optional resolver and timeout overrides.
+//
+// Returns:
+//
+//	[]*net.TCPAddr - the discovered peer addresses.
+//	error - ErrNoSeeds if the network has no seeds, an error for an invalid DefaultPort,
+//	        or the joined per-seed errors when no seed returned an address.
+func LookupSeeds(ctx context.Context, params *chaincfg.Params, requiredServices wire.ServiceFlag,
+	opts ...Option,
+) ([]*net.TCPAddr, error) {
+	o := options{resolver: net.DefaultResolver, timeout: DefaultTimeout}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	port, err := strconv.Atoi(params.DefaultPort)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default port %q for %s: %w", params.DefaultPort, params.Name, err)
+	}
+
+	seeds := make([]dnsseed.Seed, 0, len(params.DNSSeeds))
+	for _, s := range params.DNSSeeds {
+		seeds = append(seeds, dnsseed.Seed{Host: s.Host, HasFiltering: s.HasFiltering})
+	}
+
+	return dnsseed.Lookup(ctx, o.resolver, seeds, port, requiredServices, o.timeout)
+}
+
+// Bootstrap returns the initial peer addresses for a network: the results of
+// its DNS seeds, queried for full nodes, merged with its fixed seeds by
+// (*chaincfg.Params).BootstrapAddrs.
+//
+// A DNS failure is not fatal while fixed seeds remain, so networks without
+// DNS seeds still bootstrap from FixedSeeds.
+//
+// Parameters:
+//
+//	ctx - bounds the DNS lookups.
+//	params - the network to bootstrap.
+//	opts - optional resolver and timeout overrides for the DNS lookups.
+//
+// Returns:
+//
+//	[]netip.AddrPort - the merged peer addresses.
+//	error - chaincfg.ErrNoBootstrapAddrs, wrapping any DNS errors, when no address was found.
+func Bootstrap(ctx context.Context, params *chaincfg.Params, opts ...Option) ([]netip.AddrPort, error) {
+	var dnsErr error
+
+	found, err := LookupSeeds(ctx, params, wire.SFNodeNetwork, opts...)
+	if err != nil && !errors.Is(err, ErrNoSeeds) {
+		dnsErr = err
+	}
+
+	dnsAddrs := make([]netip.AddrPort, 0, len(found))
+	for _, a := range found {
+		dnsAddrs = append(dnsAddrs, a.AddrPort())
+	}
+
+	addrs, err := params.BootstrapAddrs(dnsAddrs)
+	if err != nil && dnsErr != nil {
+		return nil, fmt.Errorf("%w: %w", err, dnsErr)
+	}
+
+	return addrs, err
+}
@@ -0,0 +1,257 @@
+package seeds
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chaincfg "github.com/bsv-blockchain/go-chaincfg"
+)
+
+// errStubNotFound is returned by stubResolver for unknown hosts.
+var errStubNotFound = errors.New("no such host")
+
+// stubResolver answers lookups from a fixed table and records the queried hosts.
+type stubResolver struct {
+	mu      sync.Mutex
+	hosts   map[string][]string
+	block   map[string]bool
+	queries []string
+}
+
+// LookupIPAddr implements Resolver.
+func (r *stubResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	r.mu.Lock()
+	r.queries = append(r.queries, host)
+	r.mu.Unlock()
+
+	if r.block[host] {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	ips, ok := r.hosts[host]
+	if !ok {
+		return nil, errStubNotFound
+	}
+
+	addrs := make([]net.IPAddr, 0, len(ips))
+	for _, ip := range ips {
+		addrs = append(addrs, net.IPAddr{IP: net.ParseIP(ip)})
+	}
+
+	return addrs, nil
+}
+
+// testParams returns a network with the given seeds on port 8333.
+func testParams(seeds ...chaincfg.DNSSeed) *chaincfg.Params {
+	return &chaincfg.Params{Name: "seedtest", DefaultPort: "8333", DNSSeeds: seeds}
+}
+
+// TestLookupSeeds checks filtering subdomains, dedup and ports.
+func TestLookupSeeds(t *testing.T) {
+	r := &stubResolver{hosts: map[string][]string{
+		"x1.filter.test": {"10.0.0.1", "10.0.0.2"},
+		"plain.test":     {"10.0.0.2", "10.0.0.3"},
+	}}
+
+	params := testParams(
+		chaincfg.DNSSeed{Host: "filter.test", HasFiltering: true},
+		chaincfg.DNSSeed{Host: "plain.test", HasFiltering: false},
+	)
+
+	addrs, err := LookupSeeds(context.Background(), params, wire.SFNodeNetwork, WithResolver(r))
+	require.NoError(t, err)
+	require.Len(t, addrs, 3)
+
+	for i, want := range []string{"10.0.0.1:8333", "10.0.0.2:8333", "10.0.0.3:8333"} {
+		assert.Equal(t, want, addrs[i].String())
+	}
+
+	assert.ElementsMatch(t, []string{"x1.filter.test", "plain.test"}, r.queries)
+}
+
+// TestLookupSeedsFailures checks timeouts, partial failure and empty seed lists.
+func TestLookupSeedsFailures(t *testing.T) {
+	r := &stubResolver{
+		hosts: map[string][]string{"ok.test": {"10.0.0.9"}},
+		block: map[string]bool{"slow.test": true},
+	}
+
+	params := testParams(chaincfg.DNSSeed{Host: "slow.test"}, chaincfg.DNSSeed{Host: "ok.test"})
+
+	addrs, err := LookupSeeds(context.Background(), params, 0, WithResolver(r), WithTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	require.Len(t, addrs, 1)
+	assert.Equal(t, "10.0.0.9:8333", addrs[0].String())
+
+	params = testParams(chaincfg.DNSSeed{Host: "slow.test"}, chaincfg.DNSSeed{Host: "missing.test"})
+
+	_, err = LookupSeeds(context.Background(), params, 0, WithResolver(r), WithTimeout(10*time.Millisecond))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorIs(t, err, errStubNotFound)
+
+	params = testParams(chaincfg.DNSSeed{Host: "ok.test"})
+
+	addrs, err = LookupSeeds(context.Background(), params, 0, WithResolver(r), WithTimeout(0))
+	require.NoError(t, err, "a zero timeout falls back to DefaultTimeout")
+	require.Len(t, addrs, 1)
+
+	_, err = LookupSeeds(context.Background(), &chaincfg.StnParams, 0, WithResolver(r))
+	require.ErrorIs(t, err, ErrNoSeeds)
+
+	params = testParams(chaincfg.DNSSeed{Host: "ok.test"})
+	params.DefaultPort = "port"
+
+	_, err = LookupSeeds(context.Background(), params, 0, WithResolver(r))
+	require.Error(t, err)
+}
+
+// TestBootstrap checks the merge of DNS results and fixed seeds and the DNS
+// fallback.
+func TestBootstrap(t *testing.T) {
+	r := &stubResolver{hosts: map[string][]string{"x1.seed.test": {"192.0.2.1", "192.0.2.2"}}}
+
+	params := testParams(chaincfg.DNSSeed{Host: "seed.test", HasFiltering: true})
+	params.FixedSeeds = []netip.AddrPort{
+		netip.MustParseAddrPort("192.0.2.2:8333"),
+		netip.MustParseAddrPort("198.51.100.1:8333"),
+	}
+
+	addrs, err := Bootstrap(context.Background(), params, WithResolver(r))
+	require.NoError(t, err)
+	assert.Equal(t, []netip.AddrPort{
+		netip.MustParseAddrPort("192.0.2.1:8333"),
+		netip.MustParseAddrPort("192.0.2.2:8333"),
+		netip.MustParseAddrPort("198.51.100.1:8333"),
+	}, addrs)
+
+	// A DNS failure falls back to the fixed seeds.
+	params.DNSSeeds = []chaincfg.DNSSeed{{Host: "down.test"}}
+
+	addrs, err = Bootstrap(context.Background(), params, WithResolver(r))
+	require.NoError(t, err)
+	assert.Equal(t, params.FixedSeeds, addrs)
+
+	// With nothing to fall back on the DNS error is reported.
+	params.FixedSeeds = nil
+
+	_, err = Bootstrap(context.Background(), params, WithResolver(r))
+	require.ErrorIs(t, err, chaincfg.ErrNoBootstrapAddrs)
+	require.ErrorIs(t, err, errStubNotFound)
+
+	// A network without DNS or fixed seeds reports only the missing addresses.
+	params.DNSSeeds = nil
+
+	_, err = Bootstrap(context.Background(), params, WithResolver(r))
+	require.ErrorIs(t, err, chaincfg.ErrNoBootstrapAddrs)
+	require.NotErrorIs(t, err, ErrNoSeeds)
+}
+
+// TestLookupSeedsDNSStub resolves through a real *net.Resolver whose queries
+// are answered by an in-process UDP DNS server.
+func TestLookupSeedsDNSStub(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer func() { _ = conn.Close() }()
+
+	go serveDNSStub(conn, map[string]net.IP{
+		"x5.seed.example.": net.IPv4(192, 0, 2, 7),
+	})
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "udp", conn.LocalAddr().String())
+		},
+	}
+
+	params := testParams(chaincfg.DNSSeed{Host: "seed.example.", HasFiltering: true})
+
+	addrs, err := LookupSeeds(context.Background(), params, wire.SFNodeNetwork|wire.SFNodeBloom,
+		WithResolver(resolver), WithTimeout(2*time.Second))
+	require.NoError(t, err)
+	require.Len(t, addrs, 1)
+	assert.Equal(t, "192.0.2.7:8333", addrs[0].String())
+}
+
+// serveDNSStub answers A queries from records until conn is closed.  Other
+// query types get an empty answer and unknown names get NXDOMAIN.
+func serveDNSStub(conn net.PacketConn, records map[string]net.IP) {
+	buf := make([]byte, 512)
+
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		if resp := dnsStubResponse(buf[:n], records); resp != nil {
+			_, _ = conn.WriteTo(resp, addr)
+		}
+	}
+}
+
+// dnsStubResponse builds the reply to a single-question DNS query.
+func dnsStubResponse(query []byte, records map[string]net.IP) []byte {
+	const headerLen = 12
+
+	if len(query) < headerLen {
+		return nil
+	}
+
+	var labels []string
+
+	off := headerLen
+	for off < len(query) && query[off] != 0 {
+		l := int(query[off])
+		if off+1+l > len(query) {
+			return nil
+		}
+
+		labels = append(labels, string(query[off+1:off+1+l]))
+		off += 1 + l
+	}
+
+	off++ // root label
+	if off+4 > len(query) {
+		return nil
+	}
+
+	qtype := binary.BigEndian.Uint16(query[off:])
+	question := query[headerLen : off+4]
+	name := strings.ToLower(strings.Join(labels, ".")) + "."
+	ip, ok := records[name]
+
+	resp := make([]byte, headerLen, 512)
+	copy(resp, query[:2])
+
+	flags := uint16(0x8180)
+	if !ok {
+		flags |= 3 // NXDOMAIN
+	}
+
+	binary.BigEndian.PutUint16(resp[2:], flags)
+	binary.BigEndian.PutUint16(resp[4:], 1)
+	resp = append(resp, question...)
+
+	if ok && qtype == 1 {
+		binary.BigEndian.PutUint16(resp[6:], 1)
+		resp = append(resp, 0xc0, headerLen, 0, 1, 0, 1, 0, 0, 0, 60, 0, 4)
+		resp = append(resp, ip.To4()...)
+	}
+
+	return resp
+}
@@ -0,0 +1,184 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bsv-blockchain/go-bt/v2"
+)
+
+// These constants define the BIP0068 relative lock-time encoding of a
+// transaction input's sequence number.
+const (
+	// SequenceLockTimeDisabled is the flag that, when set, disables the
+	// relative lock-time of an input.
+	SequenceLockTimeDisabled uint32 = 1 << 31
+
+	// SequenceLockTimeIsSeconds is the flag that, when set, makes the
+	// relative lock-time a time in units of 512 seconds rather than a number
+	// of blocks.
+	SequenceLockTimeIsSeconds uint32 = 1 << 22
+
+	// SequenceLockTimeMask extracts the relative lock-time value.
+	SequenceLockTimeMask uint32 = 0x0000ffff
+
+	// SequenceLockTimeGranularity is the base-2 logarithm of the 512 second
+	// unit of time-based relative lock-times.
+	SequenceLockTimeGranularity = 9
+)
+
+// sequenceLockMinTxVersion is the lowest transaction version whose inputs are
+// subject to relative lock-times.
+const sequenceLockMinTxVersion = 2
+
+// ErrSequenceLockInputs describes an error where the input heights or times
+// passed to CalcSequenceLock do not cover every input of the transaction.
+var ErrSequenceLockInputs = errors.New("sequence lock input data mismatch")
+
+// RelativeLockTime is a decoded BIP0068 relative lock-time.
+type RelativeLockTime struct {
+	// Disabled is true when the sequence number does not impose a relative
+	// lock-time.
+	Disabled bool
+
+	// IsSeconds is true when Value counts 512 second units rather than
+	// blocks.
+	IsSeconds bool
+
+	// Value is the number of blocks or 512 second units.
+	Value uint16
+}
+
+// DecodeRelativeLockTime decodes the relative lock-time in a transaction
+// input's sequence number.  Bits outside the flags and value are ignored.
+func DecodeRelativeLockTime(sequence uint32) RelativeLockTime {
+	return RelativeLockTime{
+		Disabled:  sequence&SequenceLockTimeDisabled != 0,
+		IsSeconds: sequence&SequenceLockTimeIsSeconds != 0,
+		Value:     uint16(sequence & SequenceLockTimeMask), //nolint:gosec // masked to 16 bits
+	}
+}
+
+// Sequence returns the sequence number encoding the relative lock-time.
+func (r RelativeLockTime) Sequence() uint32 {
+	sequence := uint32(r.Value)
+
+	if r.IsSeconds {
+		sequence |= SequenceLockTimeIsSeconds
+	}
+
+	if r.Disabled {
+		sequence |= SequenceLockTimeDisabled
+	}
+
+	return sequence
+}
+
+// Duration returns the time a time-based relative lock-time waits, or 0 for
+// block-based and disabled lock-times.
+func (r RelativeLockTime) Duration() time.Duration {
+	if r.Disabled || !r.IsSeconds {
+		return 0
+	}
+
+	return time.Duration(r.Value) << SequenceLockTimeGranularity * time.Second
+}
+
+// SequenceLock is the combined relative lock-time of a transaction: the last
+// block height and median time past at which it is still locked.  A value of
+// -1 means no lock of that kind.
+type SequenceLock struct {
+	MinHeight int32
+	MinTime   int64
+}
+
+// IsSequenceLockEnforced reports whether BIP0068 relative lock-times are
+// enforced in a block at the given height: from CSVHeight on and before
+// GenesisActivationHeight.  The window is empty, so relative lock-times are
+// never enforced, when CSVHeight is not below GenesisActivationHeight, as on
+// regtest (CSV 576, Genesis 100).
+func (p *Params) IsSequenceLockEnforced(height int32) bool {
+	return height >= 0 && uint32(height) >= p.CSVHeight && uint32(height) < p.GenesisActivationHeight
+}
+
+// CalcSequenceLock computes the relative lock-time of a transaction to be
+// included in a block at the given height.
+//
+// Relative lock-times are only enforced from the network's CSVHeight up to,
+// but not including, its GenesisActivationHeight, where the Genesis upgrade
+// stopped enforcing BIP0068, and only for transactions of version 2 or later;
+// otherwise the returned lock has no effect.  See IsSequenceLockEnforced for
+// networks, such as regtest, where that window is empty.
+//
+// For each input, inputHeights holds the height of the block that created the
+// spent output, and inputMTPs the median time past of the block before that
+// one.  inputMTPs may be nil when no input uses a time-based lock.
+//
+// Parameters:
+//
+//	tx - the spending transaction.
+//	inputHeights - the creation height of each spent output.
+//	inputMTPs - the median time past before each creation block.
+//	height - the height of the block that would include tx.
+//	params - the network parameters.
+//
+// Returns:
+//
+//	*SequenceLock - the combined lock.
+//	error - ErrSequenceLockInputs if the input data does not match tx.
+func CalcSequenceLock(tx *bt.Tx, inputHeights []int32, inputMTPs []time.Time, height int32, params *Params) (*SequenceLock, error) {
+	lock := &SequenceLock{MinHeight: -1, MinTime: -1}
+
+	if !params.IsSequenceLockEnforced(height) || tx.Version < sequenceLockMinTxVersion {
+		return lock, nil
+	}
+
+	if len(inputHeights) != len(tx.Inputs) {
+		return nil, fmt.Errorf("%w: %d input heights for %d inputs", ErrSequenceLockInputs, len(inputHeights), len(tx.Inputs))
+	}
+
+	for i, in := range tx.Inputs {
+		rel := DecodeRelativeLockTime(in.SequenceNumber)
+		if rel.Disabled {
+			continue
+		}
+
+		if !rel.IsSeconds {
+			lock.MinHeight = max(lock.MinHeight, inputHeights[i]+int32(rel.Value)-1)
+			continue
+		}
+
+		if i >= len(inputMTPs) {
+			return nil, fmt.Errorf("%w: no median time past for time-locked input %d", ErrSequenceLockInputs, i)
+		}
+
+		lock.MinTime = max(lock.MinTime, inputMTPs[i].Unix()+int64(rel.Duration()/time.Second)-1)
+	}
+
+	return lock, nil
+}
+
+// SequenceLockActive reports whether a transaction's relative lock-times
+// still prevent it from being included in a block at the given height.  It
+// is always false outside the CSVHeight to GenesisActivationHeight window in
+// which BIP0068 is enforced.
+//
+// mtp is the median time past of the block before that height.  See
+// CalcSequenceLock for the other parameters.
+//
+// Returns:
+//
+//	bool - true while the transaction is still locked.
+//	error - ErrSequenceLockInputs if the input data does not match tx.
+func SequenceLockActive(tx *bt.Tx, inputHeights []int32, inputMTPs []time.Time, height int32, mtp time.Time, params *Params) (bool, error) {
+	lock, err := CalcSequenceLock(tx, inputHeights, inputMTPs, height, params)
+	if err != nil {
+		return false, err
+	}
+
+	heightLocked := lock.MinHeight >= 0 && lock.MinHeight >= height
+	timeLocked := lock.MinTime >= 0 && lock.MinTime >= mtp.Unix()
+
+	return heightLocked || timeLocked, nil
+}
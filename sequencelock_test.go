@@ -0,0 +1,170 @@
+package chaincfg
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-bt/v2"
+	"github.com/bsv-blockchain/go-bt/v2/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sequenceTx builds a transaction of the given version with one input per
+// sequence number.
+func sequenceTx(t *testing.T, version uint32, sequences ...uint32) *bt.Tx {
+	t.Helper()
+
+	tx := bt.NewTx()
+	tx.Version = version
+
+	for i, seq := range sequences {
+		in := &bt.Input{PreviousTxOutIndex: uint32(i), SequenceNumber: seq} //nolint:gosec // test index
+		require.NoError(t, in.PreviousTxIDAdd(&chainhash.Hash{1}))
+		tx.Inputs = append(tx.Inputs, in)
+	}
+
+	return tx
+}
+
+// TestDecodeRelativeLockTime checks decoding and re-encoding of sequence
+// numbers.
+func TestDecodeRelativeLockTime(t *testing.T) {
+	tests := []struct {
+		sequence uint32
+		want     RelativeLockTime
+		duration time.Duration
+	}{
+		{0, RelativeLockTime{}, 0},
+		{10, RelativeLockTime{Value: 10}, 0},
+		{0xffff, RelativeLockTime{Value: 0xffff}, 0},
+		{SequenceLockTimeIsSeconds | 2, RelativeLockTime{IsSeconds: true, Value: 2}, 1024 * time.Second},
+		{SequenceLockTimeDisabled | 5, RelativeLockTime{Disabled: true, Value: 5}, 0},
+		{math.MaxUint32, RelativeLockTime{Disabled: true, IsSeconds: true, Value: 0xffff}, 0},
+	}
+
+	for _, test := range tests {
+		got := DecodeRelativeLockTime(test.sequence)
+		assert.Equal(t, test.want, got, "%08x", test.sequence)
+		assert.Equal(t, test.duration, got.Duration(), "%08x", test.sequence)
+		assert.Equal(t, test.sequence&(SequenceLockTimeDisabled|SequenceLockTimeIsSeconds|SequenceLockTimeMask), got.Sequence())
+	}
+
+	// Bits outside the flags and value are ignored.
+	assert.Equal(t, RelativeLockTime{Value: 3}, DecodeRelativeLockTime(1<<16|3))
+}
+
+// TestCalcSequenceLock checks lock computation and activation gating.
+func TestCalcSequenceLock(t *testing.T) {
+	csv := int32(MainNetParams.CSVHeight) //nolint:gosec // fits in int32
+	coinMTP := time.Unix(1600000000, 0)
+
+	tx := sequenceTx(t, 2, 10, SequenceLockTimeIsSeconds|4, SequenceLockTimeDisabled|100)
+	heights := []int32{csv + 100, csv + 50, csv + 10}
+	mtps := []time.Time{{}, coinMTP, {}}
+
+	lock, err := CalcSequenceLock(tx, heights, mtps, csv+200, &MainNetParams)
+	require.NoError(t, err)
+	assert.Equal(t, &SequenceLock{MinHeight: csv + 109, MinTime: coinMTP.Unix() + 4*512 - 1}, lock)
+
+	// Before CSVHeight and for version 1 transactions no lock applies.
+	lock, err = CalcSequenceLock(tx, heights, mtps, csv-1, &MainNetParams)
+	require.NoError(t, err)
+	assert.Equal(t, &SequenceLock{MinHeight: -1, MinTime: -1}, lock)
+
+	lock, err = CalcSequenceLock(sequenceTx(t, 1, 10), []int32{csv}, nil, csv+1, &MainNetParams)
+	require.NoError(t, err)
+	assert.Equal(t, &SequenceLock{MinHeight: -1, MinTime: -1}, lock)
+
+	// CSV is active from genesis on stn.
+	lock, err = CalcSequenceLock(sequenceTx(t, 2, 10), []int32{5}, nil, 6, &StnParams)
+	require.NoError(t, err)
+	assert.Equal(t, int32(14), lock.MinHeight)
+
+	_, err = CalcSequenceLock(tx, heights[:2], mtps, csv+200, &MainNetParams)
+	require.ErrorIs(t, err, ErrSequenceLockInputs)
+
+	_, err = CalcSequenceLock(tx, heights, nil, csv+200, &MainNetParams)
+	require.ErrorIs(t, err, ErrSequenceLockInputs)
+}
+
+// TestSequenceLockActive checks the height and time boundaries.
+func TestSequenceLockActive(t *testing.T) {
+	coinMTP := time.Unix(1600000000, 0)
+
+	// A 10 block lock on an output created at height 50 is spendable at 60.
+	heightTx := sequenceTx(t, 2, 10)
+
+	active, err := SequenceLockActive(heightTx, []int32{50}, nil, 59, time.Time{}, &StnParams)
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	active, err = SequenceLockActive(heightTx, []int32{50}, nil, 60, time.Time{}, &StnParams)
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	// A 1024 second lock is spendable once the MTP reaches coinMTP+1024.
+	timeTx := sequenceTx(t, 2, SequenceLockTimeIsSeconds|2)
+
+	active, err = SequenceLockActive(timeTx, []int32{50}, []time.Time{coinMTP}, 90, coinMTP.Add(1023*time.Second), &StnParams)
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	active, err = SequenceLockActive(timeTx, []int32{50}, []time.Time{coinMTP}, 90, coinMTP.Add(1024*time.Second), &StnParams)
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	_, err = SequenceLockActive(timeTx, nil, nil, 90, coinMTP, &StnParams)
+	require.ErrorIs(t, err, ErrSequenceLockInputs)
+}
+
+// TestSequenceLockGenesis checks that BIP0068 stops being enforced at the
+// Genesis upgrade.
+func TestSequenceLockGenesis(t *testing.T) {
+	genesis := int32(MainNetParams.GenesisActivationHeight) //nolint:gosec // fits in int32
+	csv := int32(MainNetParams.CSVHeight)                   //nolint:gosec // fits in int32
+
+	assert.False(t, MainNetParams.IsSequenceLockEnforced(csv-1))
+	assert.True(t, MainNetParams.IsSequenceLockEnforced(csv))
+	assert.True(t, MainNetParams.IsSequenceLockEnforced(genesis-1))
+	assert.False(t, MainNetParams.IsSequenceLockEnforced(genesis))
+	assert.False(t, MainNetParams.IsSequenceLockEnforced(-1))
+
+	// An output created just before Genesis with a 10 block lock.
+	tx := sequenceTx(t, 2, 10)
+	inputHeights := []int32{genesis - 5}
+
+	active, err := SequenceLockActive(tx, inputHeights, nil, genesis-1, time.Time{}, &MainNetParams)
+	require.NoError(t, err)
+	assert.True(t, active, "locked before Genesis")
+
+	active, err = SequenceLockActive(tx, inputHeights, nil, genesis, time.Time{}, &MainNetParams)
+	require.NoError(t, err)
+	assert.False(t, active, "not enforced from Genesis on")
+
+	lock, err := CalcSequenceLock(tx, inputHeights, nil, genesis, &MainNetParams)
+	require.NoError(t, err)
+	assert.Equal(t, &SequenceLock{MinHeight: -1, MinTime: -1}, lock)
+
+	// stn activates Genesis at 100.
+	active, err = SequenceLockActive(sequenceTx(t, 2, 10), []int32{95}, nil, 99, time.Time{}, &StnParams)
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	active, err = SequenceLockActive(sequenceTx(t, 2, 10), []int32{95}, nil, 100, time.Time{}, &StnParams)
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	// regtest activates CSV (576) after Genesis (100), so the window is
+	// empty and sequence locks are never enforced there.
+	require.Greater(t, RegressionNetParams.CSVHeight, RegressionNetParams.GenesisActivationHeight)
+
+	for _, h := range []int32{0, 99, 100, 575, 576, 1000} {
+		assert.False(t, RegressionNetParams.IsSequenceLockEnforced(h), h)
+	}
+
+	active, err = SequenceLockActive(sequenceTx(t, 2, 10), []int32{570}, nil, 576, time.Time{}, &RegressionNetParams)
+	require.NoError(t, err)
+	assert.False(t, active)
+}
@@ -0,0 +1,127 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TopicKind identifies the kind of message gossiped on a libp2p topic.
+type TopicKind string
+
+// These are the libp2p topic kinds used by Teranode.
+const (
+	TopicBlock      TopicKind = "block"
+	TopicSubtree    TopicKind = "subtree"
+	TopicMiningOn   TopicKind = "mining_on"
+	TopicRejectedTx TopicKind = "rejected_tx"
+	TopicNodeStatus TopicKind = "node_status"
+	TopicHandshake  TopicKind = "handshake"
+)
+
+// topicSeparator joins a network's TopicPrefix and a TopicKind.
+const topicSeparator = "-"
+
+// TopicKinds lists every known topic kind.
+var TopicKinds = []TopicKind{
+	TopicBlock,
+	TopicSubtree,
+	TopicMiningOn,
+	TopicRejectedTx,
+	TopicNodeStatus,
+	TopicHandshake,
+}
+
+// ErrUnknownTopic describes an error where a topic name does not belong to a
+// known network or does not carry a known topic kind.
+var ErrUnknownTopic = errors.New("unknown topic")
+
+// IsValid reports whether k is one of TopicKinds.
+func (k TopicKind) IsValid() bool {
+	for _, known := range TopicKinds {
+		if k == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Topic returns the full libp2p topic name for the given kind on the network,
+// for example "teranode/bitcoin/1.0.0/mainnet-block".
+func (p *Params) Topic(kind TopicKind) string {
+	return p.TopicPrefix + topicSeparator + string(kind)
+}
+
+// TopicForVersion returns the topic name for the given kind on the network
+// under a specific protocol version.
+func (p *Params) TopicForVersion(version string, kind TopicKind) string {
+	return TopicPrefixFor(version, p.Name) + topicSeparator + string(kind)
+}
+
+// Topics returns the topic names of every known kind on the network.
+func (p *Params) Topics() []string {
+	topics := make([]string, 0, len(TopicKinds))
+	for _, kind := range TopicKinds {
+		topics = append(topics, p.Topic(kind))
+	}
+
+	return topics
+}
+
+// ParseTopic splits a libp2p topic name into the network and topic kind.
+//
+// The topic prefix is matched against the TopicPrefix and every
+// ProtocolVersions prefix of each built-in and registered network, so a
+// message arriving on another network's topic is rejected rather than
+// silently processed.
+//
+// Parameters:
+//
+//	s - the full topic name, for example "teranode/bitcoin/1.0.0/testnet-subtree".
+//
+// Returns:
+//
+//	*Params - the network the topic belongs to.
+//	TopicKind - the kind of the topic.
+//	error - ErrUnknownTopic if the prefix or kind is not recognized.
+func ParseTopic(s string) (*Params, TopicKind, error) {
+	return parseTopic(s, knownNets())
+}
+
+// parseTopic implements ParseTopic over the given networks.  A prefix match
+// with an unknown kind does not end the search, since another network's name
+// may extend the matched one, as "mainnet-x" extends "mainnet".
+func parseTopic(s string, nets []*Params) (*Params, TopicKind, error) {
+	var kindErr error
+
+	for _, p := range nets {
+		for _, prefix := range append([]string{p.TopicPrefix}, p.TopicPrefixes()...) {
+			if prefix == "" {
+				continue
+			}
+
+			rest, ok := strings.CutPrefix(s, prefix+topicSeparator)
+			if !ok {
+				continue
+			}
+
+			kind := TopicKind(rest)
+			if !kind.IsValid() {
+				if kindErr == nil {
+					kindErr = fmt.Errorf("%w: unknown kind %q on %s", ErrUnknownTopic, rest, p.Name)
+				}
+
+				continue
+			}
+
+			return p, kind, nil
+		}
+	}
+
+	if kindErr != nil {
+		return nil, "", kindErr
+	}
+
+	return nil, "", fmt.Errorf("%w: %s", ErrUnknownTopic, s)
+}
@@ -0,0 +1,86 @@
+package chaincfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTopic checks topic naming and parsing for every built-in network.
+func TestTopic(t *testing.T) {
+	assert.Equal(t, "teranode/bitcoin/1.0.0/mainnet-block", MainNetParams.Topic(TopicBlock))
+	assert.Equal(t, "teranode/bitcoin/1.0.0/tstn-rejected_tx", TeraScalingTestNetParams.Topic(TopicRejectedTx))
+
+	for _, p := range builtinNets {
+		topics := p.Topics()
+		require.Len(t, topics, len(TopicKinds))
+
+		for i, kind := range TopicKinds {
+			net, gotKind, err := ParseTopic(topics[i])
+			require.NoError(t, err, topics[i])
+			assert.Same(t, p, net)
+			assert.Equal(t, kind, gotKind)
+		}
+	}
+}
+
+// TestParseTopicErrors checks that foreign and malformed topics are rejected.
+func TestParseTopicErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"teranode/bitcoin/1.0.0/mainnet",
+		"teranode/bitcoin/1.0.0/mainnet-unknown",
+		"teranode/bitcoin/9.9.9/mainnet-block",
+		"teranode/bitcoin/1.0.0/othernet-block",
+	} {
+		_, _, err := ParseTopic(s)
+		require.ErrorIs(t, err, ErrUnknownTopic, s)
+	}
+}
+
+// TestTopicPrefixesUnique ensures no two built-in networks share gossip
+// topic prefixes for any of their protocol versions, or bootstrap peers.
+func TestTopicPrefixesUnique(t *testing.T) {
+	prefixes := make(map[string]string)
+	peers := make(map[string]string)
+
+	for _, p := range builtinNets {
+		assert.NotNil(t, p.BootstrapPeers, "%s has no explicit bootstrap peer list", p.Name)
+
+		for _, addr := range p.BootstrapPeers {
+			other, dup := peers[addr.String()]
+			assert.False(t, dup, "%s shares bootstrap peer %s with %s", p.Name, addr, other)
+
+			peers[addr.String()] = p.Name
+		}
+
+		for _, prefix := range append([]string{p.TopicPrefix}, p.TopicPrefixes()...) {
+			if other, dup := prefixes[prefix]; dup && other != p.Name {
+				assert.Fail(t, "shared topic prefix", "%s shares %s with %s", p.Name, prefix, other)
+			}
+
+			prefixes[prefix] = p.Name
+		}
+	}
+}
+
+// TestParseTopicExtendedName checks that a network whose name extends another
+// network's name is still found.
+func TestParseTopicExtendedName(t *testing.T) {
+	mainX := &Params{Name: "mainnet-x", TopicPrefix: "teranode/bitcoin/1.0.0/mainnet-x"}
+	nets := []*Params{&MainNetParams, mainX}
+
+	net, kind, err := parseTopic("teranode/bitcoin/1.0.0/mainnet-x-block", nets)
+	require.NoError(t, err)
+	assert.Same(t, mainX, net)
+	assert.Equal(t, TopicBlock, kind)
+
+	net, _, err = parseTopic("teranode/bitcoin/1.0.0/mainnet-block", nets)
+	require.NoError(t, err)
+	assert.Same(t, &MainNetParams, net)
+
+	_, _, err = parseTopic("teranode/bitcoin/1.0.0/mainnet-y-block", nets)
+	require.ErrorIs(t, err, ErrUnknownTopic)
+	assert.Contains(t, err.Error(), `unknown kind "y-block" on mainnet`)
+}
@@ -0,0 +1,200 @@
+package chaincfg
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidWIF describes an error where a WIF string cannot be decoded
+	// or has an unexpected length or compression flag.
+	ErrInvalidWIF = errors.New("invalid WIF private key")
+
+	// ErrWIFChecksum describes an error where the checksum of a WIF string
+	// does not match its payload.
+	ErrWIFChecksum = errors.New("invalid WIF checksum")
+
+	// ErrUnknownPrivateKeyID describes an error where the version byte of a
+	// WIF string does not belong to any known network.
+	ErrUnknownPrivateKeyID = errors.New("unknown private key ID")
+
+	// ErrWIFNetworkMismatch describes an error where a WIF private key
+	// belongs to a different network than the one expected.
+	ErrWIFNetworkMismatch = errors.New("WIF private key is for a different network")
+)
+
+const (
+	// wifPrivateKeyLen is the length of a secp256k1 private key in a WIF payload.
+	wifPrivateKeyLen = 32
+
+	// wifCompressFlag marks a WIF key whose public key is serialized compressed.
+	wifCompressFlag = 0x01
+)
+
+// WIF is a decoded Wallet Import Format private key.
+type WIF struct {
+	// PrivateKeyID is the version byte identifying the network.
+	PrivateKeyID byte
+
+	// PrivateKey is the 32-byte secp256k1 private key.
+	PrivateKey []byte
+
+	// CompressPubKey reports whether the key's public key should be
+	// serialized in compressed form.
+	CompressPubKey bool
+}
+
+// Networks returns every built-in or registered network whose PrivateKeyID
+// matches the key.  Testnet-style networks share 0xef, so more than one
+// network is usually returned for test keys.
+func (w *WIF) Networks() []*Params {
+	return ParamsForPrivateKeyID(w.PrivateKeyID)
+}
+
+// IsForNet reports whether the key was encoded for the given network.
+func (w *WIF) IsForNet(params *Params) bool {
+	return params != nil && w.PrivateKeyID == params.PrivateKeyID
+}
+
+// String returns the WIF encoding of the key.
+func (w *WIF) String() string {
+	return encodeWIF(w.PrivateKeyID, w.PrivateKey, w.CompressPubKey)
+}
+
+// ParamsForPrivateKeyID returns the built-in and registered networks that use
+// the given WIF version byte, in lookup order.
+//
+// Parameters:
+//
+//	id - the WIF version byte.
+//
+// Returns:
+//
+//	[]*Params - the matching networks, or nil if none match.
+func ParamsForPrivateKeyID(id byte) []*Params {
+	var nets []*Params
+
+	for _, p := range knownNets() {
+		if p.PrivateKeyID == id {
+			nets = append(nets, p)
+		}
+	}
+
+	return nets
+}
+
+// EncodeWIF encodes a private key in Wallet Import Format for the given network.
+//
+// Parameters:
+//
+//	params - the network whose PrivateKeyID is used as the version byte.
+//	privKey - the 32-byte secp256k1 private key.
+//	compress - whether the corresponding public key is serialized compressed.
+//
+// Returns:
+//
+//	string - the base58check encoded key.
+//	error - ErrUnknownNetwork if params is nil, or ErrInvalidWIF if the key
+//	is not 32 bytes long.
+func EncodeWIF(params *Params, privKey []byte, compress bool) (string, error) {
+	if params == nil {
+		return "", ErrUnknownNetwork
+	}
+
+	if len(privKey) != wifPrivateKeyLen {
+		return "", fmt.Errorf("%w: private key is %d bytes", ErrInvalidWIF, len(privKey))
+	}
+
+	return encodeWIF(params.PrivateKeyID, privKey, compress), nil
+}
+
+// DecodeWIF decodes a Wallet Import Format private key.
+//
+// The checksum and the optional compression flag are validated, and the
+// version byte must belong to a built-in or registered network.
+//
+// Parameters:
+//
+//	wif - the base58check encoded key.
+//
+// Returns:
+//
+//	*WIF - the decoded key.
+//	error - ErrInvalidWIF, ErrWIFChecksum or ErrUnknownPrivateKeyID on failure.
+func DecodeWIF(wif string) (*WIF, error) {
+	payload, err := base58CheckDecode(wif)
+	if errors.Is(err, errBase58CheckSum) {
+		return nil, ErrWIFChecksum
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWIF, err)
+	}
+
+	var compress bool
+
+	switch len(payload) {
+	case 1 + wifPrivateKeyLen:
+	case 1 + wifPrivateKeyLen + 1:
+		if payload[1+wifPrivateKeyLen] != wifCompressFlag {
+			return nil, fmt.Errorf("%w: bad compression flag 0x%02x", ErrInvalidWIF, payload[1+wifPrivateKeyLen])
+		}
+
+		compress = true
+	default:
+		return nil, fmt.Errorf("%w: length %d", ErrInvalidWIF, len(payload))
+	}
+
+	if len(ParamsForPrivateKeyID(payload[0])) == 0 {
+		return nil, fmt.Errorf("%w: 0x%02x", ErrUnknownPrivateKeyID, payload[0])
+	}
+
+	return &WIF{
+		PrivateKeyID:   payload[0],
+		PrivateKey:     payload[1 : 1+wifPrivateKeyLen],
+		CompressPubKey: compress,
+	}, nil
+}
+
+// DecodeWIFForNet decodes a Wallet Import Format private key and ensures it
+// was encoded for the given network, so that, for example, a testnet key is
+// refused by a mainnet node.
+//
+// Parameters:
+//
+//	wif - the base58check encoded key.
+//	params - the network the key must belong to.
+//
+// Returns:
+//
+//	*WIF - the decoded key.
+//	error - any error from DecodeWIF, or ErrWIFNetworkMismatch.
+func DecodeWIFForNet(wif string, params *Params) (*WIF, error) {
+	if params == nil {
+		return nil, ErrUnknownNetwork
+	}
+
+	w, err := DecodeWIF(wif)
+	if err != nil {
+		return nil, err
+	}
+
+	if !w.IsForNet(params) {
+		return nil, fmt.Errorf("%w: got version 0x%02x, want 0x%02x for %s",
+			ErrWIFNetworkMismatch, w.PrivateKeyID, params.PrivateKeyID, params.Name)
+	}
+
+	return w, nil
+}
+
+// encodeWIF serializes the version byte, key and optional compression flag
+// as a base58check string.
+func encodeWIF(id byte, privKey []byte, compress bool) string {
+	payload := make([]byte, 0, 1+wifPrivateKeyLen+1)
+	payload = append(payload, id)
+	payload = append(payload, privKey...)
+
+	if compress {
+		payload = append(payload, wifCompressFlag)
+	}
+
+	return base58CheckEncode(payload)
+}
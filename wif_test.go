@@ -0,0 +1,105 @@
+package chaincfg
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/bsv-blockchain/go-wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// wifTestKey is the private key used by the well-known WIF examples.
+const wifTestKey = "0c28fca386c7a227600b2fe50b7cae11ec86d3bf1fbe471be89827e19d72aa1d"
+
+// TestWIFRoundTrip checks encoding and decoding against known mainnet and
+// testnet vectors.
+func TestWIFRoundTrip(t *testing.T) {
+	key, err := hex.DecodeString(wifTestKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		params   *Params
+		compress bool
+		want     string
+	}{
+		{"mainnet uncompressed", &MainNetParams, false, "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"},
+		{"mainnet compressed", &MainNetParams, true, "KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617"},
+		{"testnet uncompressed", &TestNetParams, false, "91gGn1HgSap6CbU12F6z3pJri26xzp7Ay1VW6NHCoEayNXwRpu2"},
+		{"testnet compressed", &TestNetParams, true, "cMzLdeGd5vEqxB8B6VFQoRopQ3sLAAvEzDAoQgvX54xwofSWj1fx"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded, err := EncodeWIF(tc.params, key, tc.compress)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.want, encoded)
+
+			w, err := DecodeWIFForNet(encoded, tc.params)
+			require.NoError(t, err)
+			assert.Equal(t, key, w.PrivateKey)
+			assert.Equal(t, tc.compress, w.CompressPubKey)
+			assert.Equal(t, encoded, w.String())
+			assert.Contains(t, w.Networks(), tc.params)
+		})
+	}
+}
+
+// TestWIFNetworks checks network identification and the mainnet/testnet guard.
+func TestWIFNetworks(t *testing.T) {
+	key, err := hex.DecodeString(wifTestKey)
+	require.NoError(t, err)
+
+	testKey, err := EncodeWIF(&TestNetParams, key, true)
+	require.NoError(t, err)
+
+	_, err = DecodeWIFForNet(testKey, &MainNetParams)
+	require.ErrorIs(t, err, ErrWIFNetworkMismatch)
+
+	w, err := DecodeWIF(testKey)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*Params{
+		&TestNetParams, &RegressionNetParams, &StnParams, &TeraTestNetParams, &TeraScalingTestNetParams,
+	}, w.Networks())
+
+	assert.True(t, IsPrivateKeyID(wire.MainNet, 0x80))
+	assert.False(t, IsPrivateKeyID(wire.MainNet, 0xef))
+	assert.True(t, IsPrivateKeyID(wire.STN, 0xef))
+	assert.False(t, IsPrivateKeyID(wire.BitcoinNet(999), 0x80))
+}
+
+// TestDecodeWIFErrors checks that malformed keys are rejected.
+func TestDecodeWIFErrors(t *testing.T) {
+	badFlag := append([]byte{0x80}, make([]byte, 32)...)
+	badFlag = append(badFlag, 0x02)
+
+	tests := []struct {
+		name    string
+		wif     string
+		wantErr error
+	}{
+		{"bad base58", "0OIl", ErrInvalidWIF},
+		{"bad length", base58CheckEncode(make([]byte, 10)), ErrInvalidWIF},
+		{"bad checksum", "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTK", ErrWIFChecksum},
+		{"bad compression flag", base58CheckEncode(badFlag), ErrInvalidWIF},
+		{"unknown version", encodeWIF(0x42, make([]byte, 32), true), ErrUnknownPrivateKeyID},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := DecodeWIF(tc.wif)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+
+	_, err := EncodeWIF(&MainNetParams, make([]byte, 31), true)
+	require.ErrorIs(t, err, ErrInvalidWIF)
+
+	_, err = EncodeWIF(nil, make([]byte, 32), true)
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+
+	_, err = DecodeWIFForNet("5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ", nil)
+	require.ErrorIs(t, err, ErrUnknownNetwork)
+}